@@ -35,4 +35,10 @@ type TypeStruct struct {
 	Key         *TypeStruct
 	StructNames []string
 	StructTypes []*TypeStruct
+	// StructEmbedded marks the StructTypes that are embedded.
+	StructEmbedded []bool
+	// StructTags are the tags of the StructTypes, empty if they do not have one.
+	StructTags []string
+	// StructDefaults are the default values of the StructTypes, nil if they do not have one.
+	StructDefaults []Expr
 }
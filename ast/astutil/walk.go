@@ -37,6 +37,26 @@ func walkExprs(exprs []ast.Expr, f WalkFunc) error {
 	return nil
 }
 
+// walkStructDefaults walks the default values of the fields of typeStruct and of its nested structs.
+func walkStructDefaults(typeStruct *ast.TypeStruct, f WalkFunc) error {
+	if typeStruct == nil {
+		return nil
+	}
+	for i, fieldType := range typeStruct.StructTypes {
+		if fieldType.Kind == ast.TypeStructType {
+			if err := walkStructDefaults(fieldType, f); err != nil {
+				return err
+			}
+		}
+		if i < len(typeStruct.StructDefaults) {
+			if err := walkExpr(typeStruct.StructDefaults[i], f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 	//short circuit out if there are no functions
 	if stmt == nil || f == nil {
@@ -47,6 +67,14 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 	}
 	switch stmt := stmt.(type) {
 	case *ast.ImportStmt:
+	case *ast.StructStmt:
+		return walkStructDefaults(stmt.Body, f)
+	case *ast.InterfaceStmt:
+	case *ast.EnumStmt:
+	case *ast.ConstStmt:
+		if err := walkExpr(stmt.Expr, f); err != nil {
+			return err
+		}
 	case *ast.StmtsStmt:
 		if err := walkStmts(stmt.Stmts, f); err != nil {
 			return err
@@ -123,6 +151,10 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		if err := walkExpr(stmt.Expr, f); err != nil {
 			return err
 		}
+	case *ast.YieldStmt:
+		if err := walkExpr(stmt.Expr, f); err != nil {
+			return err
+		}
 	case *ast.ModuleStmt:
 		if err := walkStmt(stmt.Stmt, f); err != nil {
 			return err
@@ -140,8 +172,39 @@ func walkStmt(stmt ast.Stmt, f WalkFunc) error {
 		if err := walkStmt(stmt.Default, f); err != nil {
 			return err
 		}
+	case *ast.SelectStmt:
+		for _, selectCaseStmt := range stmt.Cases {
+			caseStmt := selectCaseStmt.(*ast.SelectCaseStmt)
+			if err := walkExpr(caseStmt.Chan, f); err != nil {
+				return err
+			}
+			if err := walkExpr(caseStmt.Send, f); err != nil {
+				return err
+			}
+			if err := walkStmt(caseStmt.Stmt, f); err != nil {
+				return err
+			}
+		}
+		if err := walkStmt(stmt.Default, f); err != nil {
+			return err
+		}
+	case *ast.TypeSwitchStmt:
+		if err := walkExpr(stmt.Expr, f); err != nil {
+			return err
+		}
+		for _, switchCaseStmt := range stmt.Cases {
+			caseStmt := switchCaseStmt.(*ast.TypeSwitchCaseStmt)
+			if err := walkStmt(caseStmt.Stmt, f); err != nil {
+				return err
+			}
+		}
+		if err := walkStmt(stmt.Default, f); err != nil {
+			return err
+		}
 	case *ast.GoroutineStmt:
 		return walkExpr(stmt.Expr, f)
+	case *ast.DeferStmt:
+		return walkExpr(stmt.Expr, f)
 	default:
 		return fmt.Errorf("unknown statement %v", reflect.TypeOf(stmt))
 	}
@@ -161,6 +224,8 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 		return walkOperator(expr.Op, f)
 	case *ast.LenExpr:
 	case *ast.LiteralExpr:
+	case *ast.InterpolatedStringExpr:
+		return walkExprs(expr.Exprs, f)
 	case *ast.IdentExpr:
 	case *ast.MemberExpr:
 		return walkExpr(expr.Expr, f)
@@ -179,6 +244,12 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 		return walkExpr(expr.End, f)
 	case *ast.ArrayExpr:
 		return walkExprs(expr.Exprs, f)
+	case *ast.StructExpr:
+		for _, field := range expr.Fields {
+			if err := walkExpr(field.Expr, f); err != nil {
+				return err
+			}
+		}
 	case *ast.MapExpr:
 		for i := range expr.Keys {
 			if err := walkExpr(expr.Keys[i], f); err != nil {
@@ -188,6 +259,8 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 				return err
 			}
 		}
+	case *ast.SpreadExpr:
+		return walkExpr(expr.Expr, f)
 	case *ast.DerefExpr:
 		return walkExpr(expr.Expr, f)
 	case *ast.AddrExpr:
@@ -207,7 +280,7 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 		if err := walkExpr(expr.Expr, f); err != nil {
 			return err
 		}
-		return walkExpr(&ast.CallExpr{Func: reflect.Value{}, SubExprs: expr.SubExprs, VarArg: expr.VarArg, Go: expr.Go}, f)
+		return walkExpr(&ast.CallExpr{Func: reflect.Value{}, SubExprs: expr.SubExprs, VarArg: expr.VarArg, Go: expr.Go, Defer: expr.Defer}, f)
 	case *ast.CallExpr:
 		return walkExprs(expr.SubExprs, f)
 	case *ast.TernaryOpExpr:
@@ -233,6 +306,23 @@ func walkExpr(expr ast.Expr, f WalkFunc) error {
 			return err
 		}
 		return walkExpr(expr.ListExpr, f)
+	case *ast.IsExpr:
+		return walkExpr(expr.Expr, f)
+	case *ast.TypeAssertExpr:
+		return walkExpr(expr.Expr, f)
+	case *ast.MatchExpr:
+		if err := walkExpr(expr.Expr, f); err != nil {
+			return err
+		}
+		for _, matchCase := range expr.Cases {
+			if err := walkExpr(matchCase.Guard, f); err != nil {
+				return err
+			}
+			if err := walkExpr(matchCase.Expr, f); err != nil {
+				return err
+			}
+		}
+		return walkExpr(expr.Default, f)
 	default:
 		return fmt.Errorf("unknown expression %v", reflect.TypeOf(expr))
 	}
@@ -26,14 +26,39 @@ type LiteralExpr struct {
 	Literal reflect.Value
 }
 
+// InterpolatedStringExpr provide interpolated string expression. ex: f"a${b}c".
+// Strings has the literal parts around Exprs, so it always has one more item.
+type InterpolatedStringExpr struct {
+	ExprImpl
+	Strings []string
+	Exprs   []Expr
+}
+
 // ArrayExpr provide Array expression.
+// Exprs can be SpreadExpr to add all the values of a slice or iterator. ex: [1, ...xs, 2].
 type ArrayExpr struct {
 	ExprImpl
 	Exprs    []Expr
 	TypeData *TypeStruct
 }
 
+// StructExpr provide struct literal expression. ex: Point{X: 1, Y: 2}, Point{1, 2}.
+// Fields are all named or all positional.
+type StructExpr struct {
+	ExprImpl
+	TypeData *TypeStruct
+	Fields   []*StructExprField
+}
+
+// StructExprField provide field of struct literal expression, Name is empty for positional fields.
+type StructExprField struct {
+	PosImpl
+	Name string
+	Expr Expr
+}
+
 // MapExpr provide Map expression.
+// A key is a SpreadExpr with a nil value for spread maps. ex: {...defaults, "k": v}.
 type MapExpr struct {
 	ExprImpl
 	Keys     []Expr
@@ -41,6 +66,12 @@ type MapExpr struct {
 	TypeData *TypeStruct
 }
 
+// SpreadExpr provide spread expression in slice and map literals. ex: [1, ...xs], {...defaults}.
+type SpreadExpr struct {
+	ExprImpl
+	Expr Expr
+}
+
 // IdentExpr provide identity expression.
 type IdentExpr struct {
 	ExprImpl
@@ -95,6 +126,7 @@ type CallExpr struct {
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Defer    bool
 }
 
 // CallErrExpr provide calling expression with error control.
@@ -113,6 +145,7 @@ type AnonCallExpr struct {
 	SubExprs []Expr
 	VarArg   bool
 	Go       bool
+	Defer    bool
 }
 
 // AnonCallErrExpr provide anonymous calling expression. ex: func(){}()?.
@@ -154,6 +187,8 @@ type FuncExpr struct {
 	Stmt   Stmt
 	Params []string
 	VarArg bool
+	// Generator is true if Stmt has yield statements.
+	Generator bool
 }
 
 // LetsExpr provide multiple expression of let.
@@ -197,3 +232,34 @@ type IncludeExpr struct {
 	ItemExpr Expr
 	ListExpr Expr
 }
+
+// IsExpr provide "is" expression, checking the type of Expr.
+type IsExpr struct {
+	ExprImpl
+	Expr Expr
+	Type *TypeStruct
+}
+
+// TypeAssertExpr provide type assertion expression.
+type TypeAssertExpr struct {
+	ExprImpl
+	Expr Expr
+	Type *TypeStruct
+}
+
+// MatchExpr provide match expression, returning the value of the first case matching the value of Expr.
+type MatchExpr struct {
+	ExprImpl
+	Expr    Expr
+	Cases   []*MatchCase
+	Default Expr
+}
+
+// MatchCase provide case of match expression.
+// Guard is checked after one of Patterns matches, with its bindings.
+type MatchCase struct {
+	PosImpl
+	Patterns []Pattern
+	Guard    Expr
+	Expr     Expr
+}
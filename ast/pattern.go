@@ -0,0 +1,66 @@
+package ast
+
+import (
+	"reflect"
+)
+
+// Pattern provides all of interfaces for patterns of match expressions.
+type Pattern interface {
+	Pos
+}
+
+// PatternImpl provide commonly implementations for Pattern.
+type PatternImpl struct {
+	PosImpl // PosImpl provide Pos() function.
+}
+
+// LiteralPattern provide pattern matching values equal to Literal.
+type LiteralPattern struct {
+	PatternImpl
+	Literal reflect.Value
+}
+
+// BindPattern provide pattern matching any value, binding it to Name. ex: x, _.
+// The name _ does not bind the value.
+type BindPattern struct {
+	PatternImpl
+	Name string
+}
+
+// RangePattern provide pattern matching numbers or strings from From to To, both included. ex: 1..10.
+type RangePattern struct {
+	PatternImpl
+	From reflect.Value
+	To   reflect.Value
+}
+
+// TypePattern provide pattern matching values of Type, binding them to Name if not empty. ex: n is int64.
+type TypePattern struct {
+	PatternImpl
+	Name string
+	Type *TypeStruct
+}
+
+// SlicePattern provide pattern matching slices and arrays item by item. ex: [first, ...rest].
+// Without Rest the length has to be the same, with Rest the remaining items are bound to it.
+type SlicePattern struct {
+	PatternImpl
+	Items   []Pattern
+	HasRest bool
+	Rest    string
+}
+
+// MapPattern provide pattern matching maps with the keys Keys and values matching Values. ex: {"kind": k}.
+type MapPattern struct {
+	PatternImpl
+	Keys   []reflect.Value
+	Values []Pattern
+}
+
+// StructPattern provide pattern matching structs of Type with the fields Fields matching Values. ex: Point{X: 0, Y: y}.
+type StructPattern struct {
+	PatternImpl
+	Type   *TypeStruct
+	Fields []string
+	Values []Pattern
+}
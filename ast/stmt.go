@@ -35,6 +35,34 @@ type StructStmt struct {
 	Body *TypeStruct
 }
 
+// ConstStmt provide "const" declaration statement.
+type ConstStmt struct {
+	StmtImpl
+	Name string
+	Expr Expr
+}
+
+// EnumStmt provide "enum" declaration statement, with its members in order.
+type EnumStmt struct {
+	StmtImpl
+	Name    string
+	Members []string
+}
+
+// InterfaceStmt provide "interface" declaration statement.
+type InterfaceStmt struct {
+	StmtImpl
+	Name    string
+	Methods []*InterfaceMethod
+}
+
+// InterfaceMethod is a method of an interface declaration.
+type InterfaceMethod struct {
+	Name   string
+	Params []string
+	VarArg bool
+}
+
 // IfStmt provide "if/else" statement.
 type IfStmt struct {
 	StmtImpl
@@ -56,6 +84,7 @@ type TryStmt struct {
 // ForStmt provide "for in" expression statement.
 type ForStmt struct {
 	StmtImpl
+	Label string
 	Vars  []string
 	Value Expr
 	Stmt  Stmt
@@ -64,6 +93,7 @@ type ForStmt struct {
 // CForStmt provide C-style "for (;;)" expression statement.
 type CForStmt struct {
 	StmtImpl
+	Label string
 	Stmt1 Stmt
 	Expr2 Expr
 	Expr3 Expr
@@ -73,18 +103,23 @@ type CForStmt struct {
 // LoopStmt provide "for expr" expression statement.
 type LoopStmt struct {
 	StmtImpl
-	Expr Expr
-	Stmt Stmt
+	Label string
+	Expr  Expr
+	Stmt  Stmt
 }
 
 // BreakStmt provide "break" expression statement.
+// Breaks with Label break the statement with that label instead of the innermost loop.
 type BreakStmt struct {
 	StmtImpl
+	Label string
 }
 
 // ContinueStmt provide "continue" expression statement.
+// Continues with Label continue the loop with that label instead of the innermost loop.
 type ContinueStmt struct {
 	StmtImpl
+	Label string
 }
 
 // ReturnStmt provide "return" expression statement.
@@ -99,6 +134,12 @@ type ThrowStmt struct {
 	Expr Expr
 }
 
+// YieldStmt provide "yield" expression statement.
+type YieldStmt struct {
+	StmtImpl
+	Expr Expr
+}
+
 // ModuleStmt provide "module" expression statement.
 type ModuleStmt struct {
 	StmtImpl
@@ -109,6 +150,7 @@ type ModuleStmt struct {
 // SwitchStmt provide switch statement.
 type SwitchStmt struct {
 	StmtImpl
+	Label   string
 	Expr    Expr
 	Cases   []Stmt
 	Default Stmt
@@ -121,6 +163,41 @@ type SwitchCaseStmt struct {
 	Stmt  Stmt
 }
 
+// TypeSwitchStmt provide type switch statement.
+type TypeSwitchStmt struct {
+	StmtImpl
+	Label   string
+	Expr    Expr
+	Cases   []Stmt
+	Default Stmt
+}
+
+// TypeSwitchCaseStmt provide type switch case statement.
+type TypeSwitchCaseStmt struct {
+	StmtImpl
+	Types []*TypeStruct
+	Stmt  Stmt
+}
+
+// SelectStmt provide select statement.
+type SelectStmt struct {
+	StmtImpl
+	Label   string
+	Cases   []Stmt
+	Default Stmt
+}
+
+// SelectCaseStmt provide select case statement.
+// Send is nil for receive cases, which set the received value to LHS and OkExpr if they are not nil.
+type SelectCaseStmt struct {
+	StmtImpl
+	Chan   Expr
+	Send   Expr
+	LHS    Expr
+	OkExpr Expr
+	Stmt   Stmt
+}
+
 // VarStmt provide statement to let variables in current scope.
 type VarStmt struct {
 	StmtImpl
@@ -149,6 +226,12 @@ type GoroutineStmt struct {
 	Expr Expr
 }
 
+// DeferStmt provide statement of defer.
+type DeferStmt struct {
+	StmtImpl
+	Expr Expr
+}
+
 // DeleteStmt provides statement of delete.
 type DeleteStmt struct {
 	ExprImpl
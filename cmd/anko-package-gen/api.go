@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// readAPI returns the Go version that added each standard library symbol,
+// keyed by package path and symbol name (ex: "strings.Cut").
+// The versions are read from the api files of GOROOT.
+func readAPI() (map[string]string, error) {
+	out, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(strings.TrimSpace(string(out)), "api", "go1*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	since := make(map[string]string)
+	for _, file := range files {
+		version := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), "go"), ".txt")
+		err = readAPIFile(file, version, since)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return since, nil
+}
+
+// readAPIFile adds the symbols of the api file to since,
+// keeping the oldest version when a symbol is already there.
+func readAPIFile(file, version string, since map[string]string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		// ex: pkg syscall (linux-386), const AF_ALG = 38
+		line := strings.TrimPrefix(scanner.Text(), "pkg ")
+		i := strings.Index(line, ", ")
+		if i < 0 {
+			continue
+		}
+		pkg, decl := line[:i], line[i+2:]
+		if j := strings.IndexByte(pkg, ' '); j >= 0 {
+			pkg = pkg[:j]
+		}
+		fields := strings.Fields(decl)
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "const", "func", "type", "var":
+		default:
+			continue
+		}
+		name := fields[1]
+		if j := strings.IndexAny(name, "(["); j >= 0 {
+			name = name[:j]
+		}
+		key := pkg + "." + name
+		if old, ok := since[key]; !ok || versionLess(version, old) {
+			since[key] = version
+		}
+	}
+	return scanner.Err()
+}
+
+// versionLess returns true if the Go version a is older than b (ex: "1.9" < "1.16").
+func versionLess(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if an != bn {
+			return an < bn
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+// +build go1.18
+
+package main
+
+import "go/types"
+
+// isGeneric returns true if obj is a generic function or type.
+// They are skipped because they cannot be bound without instantiation.
+func isGeneric(obj types.Object) bool {
+	switch t := obj.Type().(type) {
+	case *types.Signature:
+		return t.TypeParams().Len() > 0
+	case *types.Named:
+		return t.TypeParams().Len() > 0
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+// +build !go1.18
+
+package main
+
+import "go/types"
+
+// isGeneric returns false because there are no generics before go1.18.
+func isGeneric(obj types.Object) bool {
+	return false
+}
@@ -1,122 +1,280 @@
+// Command anko-package-gen generates the env.Packages and env.PackageTypes bindings of Go packages.
+//
+// Usage:
+//
+//	anko-package-gen [-o dir] [-pkg name] [-go version] [-f list] packages...
+//
+// Packages are import paths resolved by the go command, so standard library
+// and third-party module packages can be used. Without -o the bindings are
+// printed to the standard output.
 package main
 
 import (
+	"bufio"
+	"bytes"
+	"flag"
 	"fmt"
-	"go/ast"
-	"go/parser"
+	"go/constant"
+	"go/format"
+	"go/importer"
 	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 )
 
-func pkgName(f string) string {
-	file, err := parser.ParseFile(token.NewFileSet(), f, nil, parser.PackageClauseOnly)
-	if err != nil || file == nil {
-		return ""
+var (
+	flagOutput  = flag.String("o", "", "directory where the files are written, one per package")
+	flagPackage = flag.String("pkg", "", "package name of the generated files (default: base of -o or safe)")
+	flagGo      = flag.String("go", "", "skip standard library symbols added after this Go version (ex: 1.16)")
+	flagList    = flag.String("f", "", "file with the packages to generate, one per line")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("anko-package-gen: ")
+	flag.Parse()
+
+	pkgs := flag.Args()
+	if *flagList != "" {
+		list, err := readList(*flagList)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pkgs = append(pkgs, list...)
+	}
+	if len(pkgs) == 0 {
+		flag.Usage()
+		os.Exit(2)
 	}
-	return file.Name.Name
-}
 
-func isGoFile(dir os.FileInfo) bool {
-	return !dir.IsDir() &&
-		!strings.HasPrefix(dir.Name(), ".") && // ignore .files
-		filepath.Ext(dir.Name()) == ".go"
-}
+	pkgName := *flagPackage
+	if pkgName == "" {
+		pkgName = "safe"
+		if *flagOutput != "" {
+			abs, err := filepath.Abs(*flagOutput)
+			if err != nil {
+				log.Fatal(err)
+			}
+			pkgName = filepath.Base(abs)
+		}
+	}
 
-func isPkgFile(dir os.FileInfo) bool {
-	return isGoFile(dir) && !strings.HasSuffix(dir.Name(), "_test.go") // ignore test files
-}
+	var since map[string]string
+	if *flagGo != "" {
+		var err error
+		since, err = readAPI()
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	exports, err := exportFiles(pkgs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok || file == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+
+	for _, pkgPath := range pkgs {
+		pkg, err := imp.Import(pkgPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		g := &generator{pkg: pkg, since: since, maxGo: *flagGo}
+		src, err := g.generate(pkgName)
+		if err != nil {
+			log.Fatalf("%s: %v", pkgPath, err)
+		}
 
-func parseDir(p string) (map[string]*ast.Package, error) {
-	isGoFile := func(d os.FileInfo) bool {
-		return !d.IsDir() && !strings.HasSuffix(d.Name(), "_test.go") && !strings.HasPrefix(d.Name(), "example_")
+		if *flagOutput == "" {
+			os.Stdout.Write(src)
+			continue
+		}
+		file := filepath.Join(*flagOutput, strings.Replace(pkgPath, "/", ".", -1)+".go")
+		err = ioutil.WriteFile(file, src, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
+}
 
-	pkgs, err := parser.ParseDir(token.NewFileSet(), p, isGoFile, parser.ParseComments)
+// readList returns the packages listed in file, ignoring empty lines and # comments.
+func readList(file string) ([]string, error) {
+	f, err := os.Open(file)
 	if err != nil {
 		return nil, err
 	}
-	return pkgs, nil
-}
+	defer f.Close()
 
-func main() {
-	pkg := "flag"
-	if len(os.Args) == 2 {
-		pkg = os.Args[1]
+	var pkgs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line != "" {
+			pkgs = append(pkgs, line)
+		}
 	}
-	b, err := exec.Command("go", "env", "GOROOT").CombinedOutput()
+	return pkgs, scanner.Err()
+}
+
+// exportFiles returns the export data files of pkgs and their dependencies, by import path.
+func exportFiles(pkgs []string) (map[string]string, error) {
+	args := append([]string{"list", "-export", "-deps", "-f", "{{.ImportPath}}\t{{.Export}}"}, pkgs...)
+	cmd := exec.Command("go", args...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("go list: %v", err)
 	}
-	paths := []string{filepath.Join(strings.TrimSpace(string(b)), "src")}
-	b, err = exec.Command("go", "env", "GOPATH").CombinedOutput()
-	if err != nil {
-		log.Fatal(err)
+
+	exports := make(map[string]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.SplitN(line, "\t", 2)
+		if len(fields) == 2 {
+			exports[fields[0]] = fields[1]
+		}
+	}
+	return exports, nil
+}
+
+type generator struct {
+	pkg   *types.Package
+	since map[string]string
+	maxGo string
+	alias string
+}
+
+// skip returns true if the object cannot be bound.
+func (g *generator) skip(obj types.Object) bool {
+	if !obj.Exported() || isGeneric(obj) {
+		return true
 	}
-	for _, p := range strings.Split(strings.TrimSpace(string(b)), string(filepath.ListSeparator)) {
-		paths = append(paths, filepath.Join(p, "src"))
+	if g.since == nil {
+		return false
+	}
+	version, ok := g.since[g.pkg.Path()+"."+obj.Name()]
+	return ok && versionLess(g.maxGo, version)
+}
+
+// generate returns the formatted source of the bindings of g.pkg.
+func (g *generator) generate(pkgName string) ([]byte, error) {
+	g.alias = g.pkg.Name()
+	if g.alias == "reflect" && g.pkg.Path() != "reflect" || g.alias == "env" {
+		g.alias += "pkg"
 	}
-	for _, p := range paths {
-		pp := filepath.Join(p, pkg)
-		pkgs, err := parseDir(pp)
-		if err != nil || len(pkgs) == 0 {
+
+	var values, typs []string
+	scope := g.pkg.Scope()
+	for _, name := range scope.Names() {
+		obj := scope.Lookup(name)
+		if g.skip(obj) {
 			continue
 		}
-		names := map[string]bool{}
-		pn := pkg
-		for _, pp := range pkgs {
-			pn = pp.Name
-			for _, f := range pp.Files {
-				for _, d := range f.Decls {
-					switch decl := d.(type) {
-					case *ast.GenDecl:
-						for _, spec := range decl.Specs {
-							if vspec, ok := spec.(*ast.ValueSpec); ok {
-								for _, n := range vspec.Names {
-									c := n.Name[0]
-									if c < 'A' || c > 'Z' {
-										continue
-									}
-									names[n.Name] = true
-								}
-							}
-						}
-					case *ast.FuncDecl:
-						if decl.Recv != nil {
-							continue
-						}
-						c := decl.Name.Name[0]
-						if c < 'A' || c > 'Z' {
-							continue
-						}
-						names[decl.Name.Name] = true
-					}
-				}
+		switch obj := obj.(type) {
+		case *types.Const:
+			if v, ok := g.constValue(obj); ok {
+				values = append(values, fmt.Sprintf("%q: reflect.ValueOf(%s),", name, v))
 			}
+		case *types.Var, *types.Func:
+			values = append(values, fmt.Sprintf("%q: reflect.ValueOf(%s.%s),", name, g.alias, name))
+		case *types.TypeName:
+			typs = append(typs, fmt.Sprintf("%q: %s,", name, g.reflectType(obj)))
 		}
-		keys := []string{}
-		for k := range names {
-			keys = append(keys, k)
+	}
+	sort.Strings(values)
+	sort.Strings(typs)
+
+	// imports are grouped as goimports does: standard library first
+	std := []string{`"reflect"`}
+	other := []string{`"github.com/dgrr/pako/env"`}
+	if len(values) > 0 || len(typs) > 0 {
+		imp := strconv.Quote(g.pkg.Path())
+		if g.alias != path.Base(g.pkg.Path()) {
+			imp = g.alias + " " + imp
 		}
-		sort.Strings(keys)
-		fmt.Printf(`// Package %s implements %s interface for pako script.
-package %s
+		if strings.Contains(strings.Split(g.pkg.Path(), "/")[0], ".") {
+			other = append(other, imp)
+		} else if g.pkg.Path() != "reflect" {
+			std = append(std, imp)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
 
-import (
-	"%s"
-)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by anko-package-gen. DO NOT EDIT.\n\npackage %s\n\n", pkgName)
+	fmt.Fprintf(&buf, "import (\n%s\n\n%s\n)\n\nfunc init() {\n", strings.Join(std, "\n"), strings.Join(other, "\n"))
+	fmt.Fprintf(&buf, "env.Packages[%q] = map[string]reflect.Value{\n", g.pkg.Path())
+	for _, v := range values {
+		fmt.Fprintln(&buf, v)
+	}
+	buf.WriteString("}\n")
+	if len(typs) > 0 {
+		fmt.Fprintf(&buf, "env.PackageTypes[%q] = map[string]reflect.Type{\n", g.pkg.Path())
+		for _, t := range typs {
+			fmt.Fprintln(&buf, t)
+		}
+		buf.WriteString("}\n")
+	}
+	buf.WriteString("}\n")
 
-func init() {
-	Packages["%s"] = map[string]interface{}{
-`, pn, pkg, pn, pkg, pn)
-		for _, k := range keys {
-			fmt.Printf(`	"%s": %s.%s,`+"\n", k, pn, k)
+	return format.Source(buf.Bytes())
+}
+
+// constValue returns the expression of the constant.
+// Untyped constants are converted to the type the VM uses for their kind.
+func (g *generator) constValue(obj *types.Const) (string, bool) {
+	expr := g.alias + "." + obj.Name()
+	basic, ok := obj.Type().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped == 0 {
+		return expr, true
+	}
+
+	switch basic.Kind() {
+	case types.UntypedBool, types.UntypedString:
+		return expr, true
+	case types.UntypedRune:
+		return "rune(" + expr + ")", true
+	case types.UntypedInt:
+		if _, exact := constant.Int64Val(obj.Val()); exact {
+			return "int64(" + expr + ")", true
+		}
+		if _, exact := constant.Uint64Val(obj.Val()); exact {
+			return "uint64(" + expr + ")", true
 		}
-		fmt.Println(`	}
-}`)
+		return "", false
+	case types.UntypedFloat:
+		return "float64(" + expr + ")", true
+	case types.UntypedComplex:
+		return "complex128(" + expr + ")", true
+	}
+	return "", false
+}
+
+// reflectType returns the expression of the reflect.Type of the type.
+func (g *generator) reflectType(obj *types.TypeName) string {
+	expr := g.alias + "." + obj.Name()
+	if _, ok := obj.Type().Underlying().(*types.Struct); ok {
+		return "reflect.TypeOf(" + expr + "{})"
 	}
+	return "reflect.TypeOf((*" + expr + ")(nil)).Elem()"
 }
@@ -24,8 +24,11 @@ type (
 		rwMutex        *sync.RWMutex
 		parent         *Env
 		values         map[string]reflect.Value
+		constants      map[string]struct{}
 		types          map[string]reflect.Type
 		methods        map[string]reflect.Value
+		defaults       map[string]reflect.Value
+		hostValues     map[interface{}]interface{}
 		externalLookup ExternalLookup
 	}
 )
@@ -63,6 +66,10 @@ var (
 
 	// ErrSymbolContainsDot symbol contains .
 	ErrSymbolContainsDot = errors.New("symbol contains '.'")
+	// ErrNotStruct type is not a struct or a pointer to a struct
+	ErrNotStruct = errors.New("type is not a struct")
+	// ErrConstant symbol is a constant, it can not be set or defined again
+	ErrConstant = errors.New("cannot assign to constant")
 )
 
 // LoadFrom implements a script loader that will be called
@@ -133,6 +140,31 @@ func (e *Env) String() string {
 	return buffer.String()
 }
 
+// SetHostValue stores value with key in current scope.
+// Host values are state for the Go code of the host, as package Init hooks, and cannot be reached from scripts.
+// Key should be of an unexported type, like context.Context keys.
+func (e *Env) SetHostValue(key, value interface{}) {
+	e.rwMutex.Lock()
+	if e.hostValues == nil {
+		e.hostValues = make(map[interface{}]interface{})
+	}
+	e.hostValues[key] = value
+	e.rwMutex.Unlock()
+}
+
+// HostValue returns the host value stored with key in current or parent scope.
+func (e *Env) HostValue(key interface{}) (interface{}, bool) {
+	for ; e != nil; e = e.parent {
+		e.rwMutex.RLock()
+		value, ok := e.hostValues[key]
+		e.rwMutex.RUnlock()
+		if ok {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
 // GetEnvFromPath returns Env from path
 func (e *Env) GetEnvFromPath(path []string) (*Env, error) {
 	if len(path) < 1 {
@@ -183,16 +215,74 @@ func (e *Env) Copy() *Env {
 	for name, value := range e.values {
 		copy.values[name] = value
 	}
+	if e.constants != nil {
+		copy.constants = make(map[string]struct{}, len(e.constants))
+		for name := range e.constants {
+			copy.constants[name] = struct{}{}
+		}
+	}
 	if e.types != nil {
 		copy.types = make(map[string]reflect.Type, len(e.types))
 		for name, t := range e.types {
 			copy.types[name] = t
 		}
 	}
+	if e.hostValues != nil {
+		copy.hostValues = make(map[interface{}]interface{}, len(e.hostValues))
+		for key, value := range e.hostValues {
+			copy.hostValues[key] = value
+		}
+	}
 	e.rwMutex.RUnlock()
 	return &copy
 }
 
+// Values returns a copy of the values defined in the current scope, without the values of the parent scopes.
+func (e *Env) Values() map[string]reflect.Value {
+	e.rwMutex.RLock()
+	values := make(map[string]reflect.Value, len(e.values))
+	for symbol, value := range e.values {
+		values[symbol] = value
+	}
+	e.rwMutex.RUnlock()
+	return values
+}
+
+// Filter deletes the values, even constants, and the types of the current scope for which keep returns false.
+func (e *Env) Filter(keep func(symbol string) bool) {
+	e.rwMutex.Lock()
+	for symbol := range e.values {
+		if !keep(symbol) {
+			delete(e.values, symbol)
+			delete(e.constants, symbol)
+		}
+	}
+	for symbol := range e.types {
+		if !keep(symbol) {
+			delete(e.types, symbol)
+		}
+	}
+	e.rwMutex.Unlock()
+}
+
+// MoveToParent moves the values of the current scope, except constants and the ones for which keep returns true,
+// to the parent scope. Values that cannot be defined in the parent scope stay in the current one.
+func (e *Env) MoveToParent(keep func(symbol string) bool) {
+	if e.parent == nil {
+		return
+	}
+	e.rwMutex.Lock()
+	defer e.rwMutex.Unlock()
+	for symbol, value := range e.values {
+		if _, ok := e.constants[symbol]; ok || keep(symbol) {
+			continue
+		}
+		if e.parent.DefineValue(symbol, value) == nil {
+			delete(e.values, symbol)
+		}
+	}
+}
+
 // DeepCopy the Env for current scope and parent scopes.
 // Note that each scope is a consistent snapshot but not the whole.
 func (e *Env) DeepCopy() *Env {
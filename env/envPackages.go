@@ -0,0 +1,60 @@
+package env
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Package is a package that can be imported by the VM import command.
+type Package struct {
+	// Values are the package members.
+	Values map[string]reflect.Value
+	// Types are the package types.
+	Types map[string]reflect.Type
+	// Init is called on every import with the Env of the imported package.
+	// The Env is a child of the importing scope, so values of the current run can be reached from it.
+	// It can be used to define per import state (ex: a logger bound to the current run).
+	// The import policy is applied after Init, so it also restricts the members defined by Init.
+	Init func(pack *Env) error
+}
+
+type lazyPackage struct {
+	mutex  sync.Mutex
+	loaded bool
+	load   func() Package
+	pkg    Package
+}
+
+var (
+	lazyPackagesMutex sync.Mutex
+	lazyPackages      = make(map[string]*lazyPackage)
+)
+
+// RegisterPackage registers a package that will be loaded the first time it is imported.
+// Packages registered with RegisterPackage take precedence over Packages and PackageTypes.
+func RegisterPackage(name string, load func() Package) {
+	lazyPackagesMutex.Lock()
+	lazyPackages[name] = &lazyPackage{load: load}
+	lazyPackagesMutex.Unlock()
+}
+
+// GetPackage returns the package called name, loading it if it was registered with RegisterPackage.
+// If load panics, the panic is propagated and the package is loaded again the next time.
+func GetPackage(name string) (Package, bool) {
+	lazyPackagesMutex.Lock()
+	lazy, ok := lazyPackages[name]
+	lazyPackagesMutex.Unlock()
+	if ok {
+		lazy.mutex.Lock()
+		defer lazy.mutex.Unlock()
+		// loaded is only set when load returns, so a package whose load panics is loaded again on the next import
+		if !lazy.loaded {
+			lazy.pkg = lazy.load()
+			lazy.loaded = true
+		}
+		return lazy.pkg, true
+	}
+
+	values, ok := Packages[name]
+	return Package{Values: values, Types: PackageTypes[name]}, ok
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegisterPackage(t *testing.T) {
+	var loads int
+	RegisterPackage("testLazyPackage", func() Package {
+		loads++
+		return Package{
+			Values: map[string]reflect.Value{"a": reflect.ValueOf(1)},
+			Types:  map[string]reflect.Type{"b": reflect.TypeOf(true)},
+		}
+	})
+	if loads != 0 {
+		t.Fatalf("RegisterPackage - received loads: %v - expected: %v", loads, 0)
+	}
+
+	for i := 0; i < 2; i++ {
+		pkg, ok := GetPackage("testLazyPackage")
+		if !ok {
+			t.Fatal("GetPackage - package not found")
+		}
+		if !pkg.Values["a"].IsValid() || pkg.Values["a"].Interface() != 1 {
+			t.Errorf("GetPackage - received: %v - expected: %v", pkg.Values["a"], 1)
+		}
+		if pkg.Types["b"] != reflect.TypeOf(true) {
+			t.Errorf("GetPackage - received: %v - expected: %v", pkg.Types["b"], reflect.TypeOf(true))
+		}
+	}
+	if loads != 1 {
+		t.Errorf("GetPackage - received loads: %v - expected: %v", loads, 1)
+	}
+
+	_, ok := GetPackage("testMissingPackage")
+	if ok {
+		t.Error("GetPackage - expected package not found")
+	}
+}
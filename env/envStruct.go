@@ -0,0 +1,41 @@
+package env
+
+import (
+	"reflect"
+)
+
+// DefineStruct defines the Go struct type of value as symbol, so scripts can use make(symbol).
+// value can be a struct, a pointer to a struct or their reflect.Type.
+//
+// The constructors are defined in a module called symbol, so scripts can call symbol.Name(...).
+// Exported fields and methods, including pointer receiver methods, can be used from scripts.
+// Fields can be renamed for scripts with a `pako:"name"` tag, which hides their Go name.
+func (e *Env) DefineStruct(symbol string, value interface{}, constructors map[string]interface{}) error {
+	t, ok := value.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(value)
+	}
+	if t == nil {
+		return ErrNotStruct
+	}
+	if t.Kind() != reflect.Struct && (t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct) {
+		return ErrNotStruct
+	}
+
+	err := e.DefineReflectType(symbol, t)
+	if err != nil {
+		return err
+	}
+	if len(constructors) == 0 {
+		return nil
+	}
+
+	module := e.NewEnv()
+	for name, constructor := range constructors {
+		err = module.Define(name, constructor)
+		if err != nil {
+			return err
+		}
+	}
+	return e.Define(symbol, module)
+}
@@ -0,0 +1,68 @@
+package env
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testDefineStruct struct {
+	A int64 `pako:"a"`
+}
+
+func TestDefineStruct(t *testing.T) {
+	tests := []struct {
+		testInfo     string
+		value        interface{}
+		constructors map[string]interface{}
+		defineError  error
+	}{
+		{testInfo: "struct", value: testDefineStruct{}},
+		{testInfo: "pointer", value: &testDefineStruct{}},
+		{testInfo: "reflect type", value: reflect.TypeOf(testDefineStruct{})},
+		{testInfo: "constructors", value: &testDefineStruct{}, constructors: map[string]interface{}{"New": func() *testDefineStruct { return &testDefineStruct{} }}},
+		{testInfo: "nil", value: nil, defineError: ErrNotStruct},
+		{testInfo: "int", value: int64(1), defineError: ErrNotStruct},
+		{testInfo: "pointer to int", value: new(int64), defineError: ErrNotStruct},
+	}
+
+	for _, test := range tests {
+		env := NewEnv()
+		err := env.DefineStruct("a", test.value, test.constructors)
+		if err != test.defineError {
+			t.Errorf("TestDefineStruct %v - DefineStruct error - received: %v - expected: %v", test.testInfo, err, test.defineError)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		valueType, err := env.Type("a")
+		if err != nil {
+			t.Errorf("TestDefineStruct %v - Type error - received: %v - expected: %v", test.testInfo, err, nil)
+			continue
+		}
+		expectedType, ok := test.value.(reflect.Type)
+		if !ok {
+			expectedType = reflect.TypeOf(test.value)
+		}
+		if valueType != expectedType {
+			t.Errorf("TestDefineStruct %v - Type check - received: %v - expected: %v", test.testInfo, valueType, expectedType)
+		}
+
+		module, err := env.Get("a")
+		if len(test.constructors) == 0 {
+			if err == nil {
+				t.Errorf("TestDefineStruct %v - Get - received: %v - expected: undefined symbol", test.testInfo, module)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("TestDefineStruct %v - Get error - received: %v - expected: %v", test.testInfo, err, nil)
+			continue
+		}
+		_, err = module.(*Env).Get("New")
+		if err != nil {
+			t.Errorf("TestDefineStruct %v - Get constructor error - received: %v - expected: %v", test.testInfo, err, nil)
+		}
+	}
+}
@@ -109,3 +109,33 @@ func (e *Env) DefineMethod(symbol string, v reflect.Value) {
 	e.methods[symbol] = v
 	e.rwMutex.Unlock()
 }
+
+// Defaults returns the default values of the fields of a struct.
+//
+// The symbol should be the name of the struct. Like Type, it stops at the scope where
+// the type is first found, so a type that shadows a struct does not get its defaults.
+func (e *Env) Defaults(symbol string) (reflect.Value, error) {
+	e.rwMutex.RLock()
+	v, ok := e.defaults[symbol]
+	_, isType := e.types[symbol]
+	e.rwMutex.RUnlock()
+	if ok {
+		return v, nil
+	}
+
+	if isType || e.parent == nil {
+		return reflect.Value{}, fmt.Errorf("undefined defaults '%s'", symbol)
+	}
+
+	return e.parent.Defaults(symbol)
+}
+
+// DefineDefaults will define the default values of the fields of a struct.
+func (e *Env) DefineDefaults(symbol string, v reflect.Value) {
+	e.rwMutex.Lock()
+	if e.defaults == nil {
+		e.defaults = make(map[string]reflect.Value)
+	}
+	e.defaults[symbol] = v
+	e.rwMutex.Unlock()
+}
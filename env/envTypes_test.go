@@ -311,3 +311,30 @@ func TestDefineTypeFail(t *testing.T) {
 		}
 	}
 }
+
+func TestDefaults(t *testing.T) {
+	envParent := NewEnv()
+	if err := envParent.DefineType("a", struct{ A int64 }{}); err != nil {
+		t.Fatalf("DefineType error: %v", err)
+	}
+	envParent.DefineDefaults("a", reflect.ValueOf(1))
+	envChild := envParent.NewEnv()
+
+	value, err := envChild.Defaults("a")
+	if err != nil {
+		t.Fatalf("Defaults error: %v", err)
+	}
+	if value.Interface() != 1 {
+		t.Errorf("Defaults - received: %v - expected: %v", value, 1)
+	}
+
+	// a type that shadows a struct does not get its defaults
+	if err := envChild.DefineType("a", struct{ B int64 }{}); err != nil {
+		t.Fatalf("DefineType error: %v", err)
+	}
+	_, err = envChild.Defaults("a")
+	expected := "undefined defaults 'a'"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Defaults error - received: %v - expected: %v", err, expected)
+	}
+}
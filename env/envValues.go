@@ -19,13 +19,41 @@ func (e *Env) Define(symbol string, value interface{}) error {
 }
 
 // DefineValue defines/sets reflect value to symbol in current scope.
+// It fails if symbol is a constant of the current scope.
 func (e *Env) DefineValue(symbol string, value reflect.Value) error {
+	return e.defineValue(symbol, value, false)
+}
+
+// DefineConst defines interface value to symbol in current scope as a constant.
+func (e *Env) DefineConst(symbol string, value interface{}) error {
+	if value == nil {
+		return e.DefineConstValue(symbol, NilValue)
+	}
+	return e.DefineConstValue(symbol, reflect.ValueOf(value))
+}
+
+// DefineConstValue defines reflect value to symbol in current scope as a constant.
+// Constants can not be set, nor defined again in the same scope.
+func (e *Env) DefineConstValue(symbol string, value reflect.Value) error {
+	return e.defineValue(symbol, value, true)
+}
+
+func (e *Env) defineValue(symbol string, value reflect.Value, constant bool) error {
 	if strings.Contains(symbol, ".") {
 		return ErrSymbolContainsDot
 	}
 	e.rwMutex.Lock()
+	defer e.rwMutex.Unlock()
+	if _, ok := e.constants[symbol]; ok {
+		return fmt.Errorf("%w '%s'", ErrConstant, symbol)
+	}
 	e.values[symbol] = value
-	e.rwMutex.Unlock()
+	if constant {
+		if e.constants == nil {
+			e.constants = make(map[string]struct{})
+		}
+		e.constants[symbol] = struct{}{}
+	}
 
 	return nil
 }
@@ -75,8 +103,12 @@ func getUnderlayedType(v reflect.Value) interface{} {
 func (e *Env) SetValueEvict(symbol string, value reflect.Value) (reflect.Value, error) {
 	e.rwMutex.RLock()
 	v, ok := e.values[symbol]
+	_, constant := e.constants[symbol]
 	e.rwMutex.RUnlock()
 	if ok {
+		if constant {
+			return v, fmt.Errorf("%w '%s'", ErrConstant, symbol)
+		}
 		if v.Type().Implements(over.SetReflectType) {
 			a := v.Interface().(over.Set)
 			vl := getUnderlayedType(value)
@@ -129,10 +161,11 @@ func (e *Env) GetValue(symbol string) (reflect.Value, error) {
 
 // delete
 
-// Delete deletes symbol in current scope.
+// Delete deletes symbol in current scope, even if it is a constant.
 func (e *Env) Delete(symbol string) {
 	e.rwMutex.Lock()
 	delete(e.values, symbol)
+	delete(e.constants, symbol)
 	e.rwMutex.Unlock()
 }
 
@@ -1,6 +1,7 @@
 package env
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"sync"
@@ -425,6 +426,62 @@ func TestAddr(t *testing.T) {
 	}
 }
 
+func TestDefineConst(t *testing.T) {
+	envParent := NewEnv()
+	err := envParent.DefineConst("a", "a")
+	if err != nil {
+		t.Fatal("DefineConst error:", err)
+	}
+
+	expectedError := "cannot assign to constant 'a'"
+	envChild := envParent.NewEnv()
+	err = envChild.Set("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Set error - received: %v - expected: %v", err, expectedError)
+	}
+	if !errors.Is(err, ErrConstant) {
+		t.Errorf("Set error - received: %v - expected: %v", err, ErrConstant)
+	}
+	err = envParent.Define("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Define error - received: %v - expected: %v", err, expectedError)
+	}
+	err = envParent.DefineConst("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("DefineConst error - received: %v - expected: %v", err, expectedError)
+	}
+
+	// child scopes can shadow constants
+	err = envChild.Define("a", "c")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	value, err := envParent.Get("a")
+	if err != nil {
+		t.Fatal("Get error:", err)
+	}
+	if value != "a" {
+		t.Errorf("Get value - received: %#v - expected: %#v", value, "a")
+	}
+
+	// copies keep constants
+	err = envParent.Copy().Set("a", "b")
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Copy Set error - received: %v - expected: %v", err, expectedError)
+	}
+
+	// deleted constants can be defined again
+	envParent.Delete("a")
+	err = envParent.Define("a", "b")
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = envParent.Set("a", "c")
+	if err != nil {
+		t.Fatal("Set error:", err)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	// empty
 	env := NewEnv()
@@ -361,6 +361,88 @@ func TestCopy(t *testing.T) {
 	}
 }
 
+func TestHostValue(t *testing.T) {
+	t.Parallel()
+
+	type key struct{}
+	parent := NewEnv()
+	parent.SetHostValue(key{}, "a")
+	child := parent.NewEnv()
+	if v, ok := child.HostValue(key{}); !ok || v != "a" {
+		t.Errorf("HostValue - received: %v, %v - expected: a, true", v, ok)
+	}
+	child.SetHostValue(key{}, "b")
+	if v, ok := child.Copy().HostValue(key{}); !ok || v != "b" {
+		t.Errorf("HostValue of copy - received: %v, %v - expected: b, true", v, ok)
+	}
+	if v, ok := parent.HostValue(key{}); !ok || v != "a" {
+		t.Errorf("HostValue of parent - received: %v, %v - expected: a, true", v, ok)
+	}
+	if _, err := child.Get("key"); err == nil {
+		t.Errorf("host value is reachable as a value")
+	}
+	if _, ok := NewEnv().HostValue(key{}); ok {
+		t.Errorf("HostValue of new Env returned true")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	parent := NewEnv()
+	parent.Define("a", "a")
+	child := parent.NewEnv()
+	child.Define("b", "b")
+	child.DefineConst("c", "c")
+	child.DefineType("d", []int64{})
+	child.DefineType("e", []bool{})
+	child.Filter(func(symbol string) bool { return symbol == "b" || symbol == "e" })
+
+	values := child.Values()
+	if len(values) != 1 || values["b"].Interface() != "b" {
+		t.Errorf("Values - received: %v - expected: map[b:b]", values)
+	}
+	if _, err := child.Get("c"); err == nil {
+		t.Errorf("constant was not deleted")
+	}
+	if err := child.Define("c", "x"); err != nil {
+		t.Errorf("Define deleted constant error: %v", err)
+	}
+	if _, err := child.Type("d"); err == nil {
+		t.Errorf("type was not deleted")
+	}
+	if _, err := child.Type("e"); err != nil {
+		t.Errorf("type was deleted")
+	}
+	if v, err := child.Get("a"); err != nil || v != "a" {
+		t.Errorf("parent value was deleted")
+	}
+}
+
+func TestMoveToParent(t *testing.T) {
+	t.Parallel()
+
+	parent := NewEnv()
+	parent.DefineConst("d", "d")
+	child := parent.NewEnv()
+	child.Define("a", "a")
+	child.Define("b", "b")
+	child.DefineConst("c", "c")
+	child.Define("d", "x")
+	child.MoveToParent(func(symbol string) bool { return symbol == "b" })
+
+	values := parent.Values()
+	if len(values) != 2 || values["a"].Interface() != "a" || values["d"].Interface() != "d" {
+		t.Errorf("parent Values - received: %v - expected: map[a:a d:d]", values)
+	}
+	values = child.Values()
+	if len(values) != 3 || values["b"].Interface() != "b" || values["c"].Interface() != "c" || values["d"].Interface() != "x" {
+		t.Errorf("child Values - received: %v - expected: map[b:b c:c d:x]", values)
+	}
+
+	NewEnv().MoveToParent(func(string) bool { return false })
+}
+
 func TestDeepCopy(t *testing.T) {
 	t.Parallel()
 
@@ -1,3 +1,3 @@
 module github.com/dgrr/pako
 
-go 1.14
+go 1.16
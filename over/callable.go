@@ -0,0 +1,16 @@
+package over
+
+import "reflect"
+
+type Callable interface {
+	// Call x(args)
+	Call(args ...interface{}) (interface{}, error)
+}
+
+var CallableReflectType = reflect.TypeOf(new(Callable)).Elem()
+
+type CallableImpl struct{}
+
+func (c *CallableImpl) Call(_ ...interface{}) (interface{}, error) {
+	return nil, ErrMethodNotImplemented
+}
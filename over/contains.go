@@ -0,0 +1,16 @@
+package over
+
+import "reflect"
+
+type Contains interface {
+	// Contains v in x
+	Contains(v interface{}) (bool, error)
+}
+
+var ContainsReflectType = reflect.TypeOf(new(Contains)).Elem()
+
+type ContainsImpl struct{}
+
+func (c *ContainsImpl) Contains(_ interface{}) (bool, error) {
+	return false, ErrMethodNotImplemented
+}
@@ -0,0 +1,14 @@
+package over
+
+import "reflect"
+
+// Hasher is implemented by values that can be used as keys of script maps
+// when they are not comparable or Go equality is not the expected one.
+type Hasher interface {
+	// Hash returns the same value for keys that are equal.
+	Hash() uint64
+	// Equals returns nil if the key is equal to v, like Comparison.
+	Equals(v interface{}) error
+}
+
+var HasherReflectType = reflect.TypeOf(new(Hasher)).Elem()
@@ -0,0 +1,74 @@
+package over
+
+import "reflect"
+
+type Iterator interface {
+	// Next advances to the next value of for in loops.
+	// It returns false when there are no more values or on error.
+	Next() bool
+	// Value returns the current value.
+	Value() interface{}
+	// Err returns the error that stopped the iteration, if any.
+	Err() error
+}
+
+// KeyIterator is an Iterator with keys, used by for k, v in loops.
+// Iterators without keys use the iteration index as key.
+type KeyIterator interface {
+	Iterator
+	// Key returns the current key.
+	Key() interface{}
+}
+
+var (
+	IteratorReflectType    = reflect.TypeOf(new(Iterator)).Elem()
+	KeyIteratorReflectType = reflect.TypeOf(new(KeyIterator)).Elem()
+)
+
+type IteratorImpl struct{}
+
+func (it *IteratorImpl) Next() bool {
+	return false
+}
+
+func (it *IteratorImpl) Value() interface{} {
+	return nil
+}
+
+func (it *IteratorImpl) Err() error {
+	return ErrMethodNotImplemented
+}
+
+// Pull returns an Iterator that gets its values from next until it returns false or an error.
+func Pull(next func() (interface{}, bool, error)) Iterator {
+	return &pullIterator{next: next}
+}
+
+type pullIterator struct {
+	next  func() (interface{}, bool, error)
+	value interface{}
+	err   error
+	done  bool
+}
+
+func (it *pullIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	var ok bool
+	it.value, ok, it.err = it.next()
+	if !ok || it.err != nil {
+		it.done = true
+		it.value = nil
+		return false
+	}
+	return true
+}
+
+func (it *pullIterator) Value() interface{} {
+	return it.value
+}
+
+func (it *pullIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,30 @@
+package over
+
+import "reflect"
+
+type Getter interface {
+	// Get x.name, called for members x does not have
+	Get(name string) (interface{}, error)
+}
+
+type Setter interface {
+	// Set x.name = v, called for members x does not have
+	Set(name string, v interface{}) error
+}
+
+var (
+	GetterReflectType = reflect.TypeOf(new(Getter)).Elem()
+	SetterReflectType = reflect.TypeOf(new(Setter)).Elem()
+)
+
+type GetterImpl struct{}
+
+func (g *GetterImpl) Get(_ string) (interface{}, error) {
+	return nil, ErrMethodNotImplemented
+}
+
+type SetterImpl struct{}
+
+func (s *SetterImpl) Set(_ string, _ interface{}) error {
+	return ErrMethodNotImplemented
+}
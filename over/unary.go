@@ -0,0 +1,30 @@
+package over
+
+import "reflect"
+
+type Unary interface {
+	// Neg -x
+	Neg() (interface{}, error)
+	// Not !x
+	Not() (interface{}, error)
+	// BitNot ^x
+	BitNot() (interface{}, error)
+}
+
+var UnaryReflectType = reflect.TypeOf(new(Unary)).Elem()
+
+// UnaryImpl can be embedded to implement only some Unary methods.
+// The operators of the methods returning ErrMethodNotImplemented keep their default behaviour.
+type UnaryImpl struct{}
+
+func (u *UnaryImpl) Neg() (interface{}, error) {
+	return nil, ErrMethodNotImplemented
+}
+
+func (u *UnaryImpl) Not() (interface{}, error) {
+	return nil, ErrMethodNotImplemented
+}
+
+func (u *UnaryImpl) BitNot() (interface{}, error) {
+	return nil, ErrMethodNotImplemented
+}
@@ -1,5 +0,0 @@
-// +build !go1.7
-
-package safe
-
-func bytesGo17() {}
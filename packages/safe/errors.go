@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package safe
 
 import (
@@ -9,6 +11,9 @@ import (
 
 func init() {
 	env.Packages["errors"] = map[string]reflect.Value{
-		"New": reflect.ValueOf(errors.New),
+		"As":     reflect.ValueOf(errors.As),
+		"Is":     reflect.ValueOf(errors.Is),
+		"New":    reflect.ValueOf(errors.New),
+		"Unwrap": reflect.ValueOf(errors.Unwrap),
 	}
 }
@@ -0,0 +1,48 @@
+package safe
+
+import (
+	"io/fs"
+
+	"github.com/dgrr/pako/env"
+	"github.com/dgrr/pako/vfs"
+)
+
+func init() {
+	env.RegisterPackage("fs", func() env.Package {
+		return env.Package{Init: func(pack *env.Env) error {
+			fsys, ok := vfs.FromEnv(pack)
+			if !ok {
+				return vfs.ErrNotBound
+			}
+			members := map[string]interface{}{
+				"Glob": func(pattern string) ([]string, error) {
+					return fs.Glob(fsys, pattern)
+				},
+				"MkdirAll": func(name string) error {
+					return fsys.MkdirAll(name, 0755)
+				},
+				"ReadDir": func(name string) ([]fs.DirEntry, error) {
+					return fs.ReadDir(fsys, name)
+				},
+				"ReadFile": func(name string) ([]byte, error) {
+					return fs.ReadFile(fsys, name)
+				},
+				"Remove": func(name string) error {
+					return fsys.Remove(name)
+				},
+				"Stat": func(name string) (fs.FileInfo, error) {
+					return fs.Stat(fsys, name)
+				},
+				"WriteFile": func(name string, data []byte) error {
+					return fsys.WriteFile(name, data, 0644)
+				},
+			}
+			for name, member := range members {
+				if err := pack.Define(name, member); err != nil {
+					return err
+				}
+			}
+			return nil
+		}}
+	})
+}
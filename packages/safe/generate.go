@@ -0,0 +1,3 @@
+package safe
+
+//go:generate go run ../../cmd/anko-package-gen -o . -go 1.16 bytes errors math math/rand net/url path regexp strconv strings sync time
@@ -1,3 +1,5 @@
+// Code generated by anko-package-gen. DO NOT EDIT.
+
 package safe
 
 import (
@@ -18,9 +20,21 @@ func init() {
 		"Int63":       reflect.ValueOf(rand.Int63),
 		"Int63n":      reflect.ValueOf(rand.Int63n),
 		"Intn":        reflect.ValueOf(rand.Intn),
+		"New":         reflect.ValueOf(rand.New),
+		"NewSource":   reflect.ValueOf(rand.NewSource),
+		"NewZipf":     reflect.ValueOf(rand.NewZipf),
 		"NormFloat64": reflect.ValueOf(rand.NormFloat64),
 		"Perm":        reflect.ValueOf(rand.Perm),
+		"Read":        reflect.ValueOf(rand.Read),
 		"Seed":        reflect.ValueOf(rand.Seed),
+		"Shuffle":     reflect.ValueOf(rand.Shuffle),
 		"Uint32":      reflect.ValueOf(rand.Uint32),
+		"Uint64":      reflect.ValueOf(rand.Uint64),
+	}
+	env.PackageTypes["math/rand"] = map[string]reflect.Type{
+		"Rand":     reflect.TypeOf(rand.Rand{}),
+		"Source":   reflect.TypeOf((*rand.Source)(nil)).Elem(),
+		"Source64": reflect.TypeOf((*rand.Source64)(nil)).Elem(),
+		"Zipf":     reflect.TypeOf(rand.Zipf{}),
 	}
 }
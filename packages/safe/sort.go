@@ -29,6 +29,9 @@ func init() {
 		"SearchFloat64s":    reflect.ValueOf(sort.SearchFloat64s),
 		"SearchInts":        reflect.ValueOf(sort.SearchInts),
 		"SearchStrings":     reflect.ValueOf(sort.SearchStrings),
+		"Slice":             reflect.ValueOf(sort.Slice),
+		"SliceIsSorted":     reflect.ValueOf(sort.SliceIsSorted),
+		"SliceStable":       reflect.ValueOf(sort.SliceStable),
 		"Sort":              reflect.ValueOf(sort.Sort),
 		"Stable":            reflect.ValueOf(sort.Stable),
 		"Strings":           reflect.ValueOf(sort.Strings),
@@ -40,5 +43,4 @@ func init() {
 		"StringSlice":     reflect.TypeOf(sort.StringSlice{}),
 		"SortFuncsStruct": reflect.TypeOf(&SortFuncsStruct{}),
 	}
-	sortGo18()
 }
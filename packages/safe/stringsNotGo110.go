@@ -1,5 +0,0 @@
-// +build !go1.10
-
-package safe
-
-func stringsGo110() {}
@@ -1,5 +0,0 @@
-// +build !go1.9
-
-package safe
-
-func syncGo19() {}
@@ -16,6 +16,7 @@ import (
 	"github.com/dgrr/pako/env"
 	_ "github.com/dgrr/pako/packages"
 	"github.com/dgrr/pako/parser"
+	"github.com/dgrr/pako/vfs"
 	"github.com/dgrr/pako/vm"
 )
 
@@ -65,6 +66,7 @@ func setupEnv() {
 	e = env.NewEnv()
 	e.Define("args", args)
 	e.Import = doImport
+	vfs.Bind(e, vfs.NewMem())
 	core.Import(e)
 }
 
@@ -0,0 +1,363 @@
+package parser
+
+import (
+	"github.com/dgrr/pako/ast"
+)
+
+// constName is a name defined by a script, a constant or not.
+type constName struct {
+	isConst bool
+	pos     ast.Position
+}
+
+// constScope is a scope of the names defined by a script, like the environments the VM creates to run it.
+type constScope struct {
+	parent *constScope
+	names  map[string]constName
+}
+
+func newConstScope(parent *constScope) *constScope {
+	return &constScope{parent: parent, names: make(map[string]constName)}
+}
+
+func (s *constScope) define(name string, isConst bool, pos ast.Position) {
+	s.names[name] = constName{isConst: isConst, pos: pos}
+}
+
+func (s *constScope) lookup(name string) (constName, bool) {
+	for ; s != nil; s = s.parent {
+		if def, ok := s.names[name]; ok {
+			return def, true
+		}
+	}
+	return constName{}, false
+}
+
+// constFunc is a function to check with the scope it is defined in.
+type constFunc struct {
+	expr  *ast.FuncExpr
+	scope *constScope
+}
+
+// constChecker finds the assignments to the constants defined by a script, which fail when they run.
+// The bodies of the functions are checked after the statements around them,
+// so they see the names defined after the functions, as they usually run after these are defined.
+// Only the constants defined before an assignment are reported, so the checked assignments always fail.
+type constChecker struct {
+	funcs []constFunc
+	err   *Error
+}
+
+// checkConsts returns an error for the first assignment of stmt to a constant defined by it.
+func checkConsts(stmt ast.Stmt) *Error {
+	c := &constChecker{}
+	c.stmt(stmt, newConstScope(nil))
+	for i := 0; i < len(c.funcs) && c.err == nil; i++ {
+		fn := c.funcs[i]
+		scope := newConstScope(fn.scope)
+		if fn.expr.Recv != "" {
+			scope.define(fn.expr.Recv, false, fn.expr.Position())
+		}
+		for _, param := range fn.expr.Params {
+			scope.define(param, false, fn.expr.Position())
+		}
+		c.stmt(fn.expr.Stmt, scope)
+	}
+	return c.err
+}
+
+func (c *constChecker) stmts(stmts []ast.Stmt, scope *constScope) {
+	for _, stmt := range stmts {
+		c.stmt(stmt, scope)
+	}
+}
+
+func (c *constChecker) stmt(stmt ast.Stmt, scope *constScope) {
+	if stmt == nil || c.err != nil {
+		return
+	}
+	switch stmt := stmt.(type) {
+	case *ast.StmtsStmt:
+		c.stmts(stmt.Stmts, scope)
+	case *ast.ExprStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.ImportStmt:
+		switch {
+		case stmt.As != "":
+			scope.define(stmt.As, false, stmt.Position())
+		case stmt.Local:
+		default:
+			switch name := stmt.Name.(type) {
+			case *ast.IdentExpr:
+				scope.define(name.Lit, false, stmt.Position())
+			case *ast.MemberExpr:
+				scope.define(name.Name, false, stmt.Position())
+			}
+		}
+	case *ast.ConstStmt:
+		c.expr(stmt.Expr, scope)
+		scope.define(stmt.Name, true, stmt.Position())
+	case *ast.EnumStmt:
+		scope.define(stmt.Name, true, stmt.Position())
+	case *ast.InterfaceStmt:
+		scope.define(stmt.Name, true, stmt.Position())
+	case *ast.VarStmt:
+		c.exprs(stmt.Exprs, scope)
+		for _, name := range stmt.Names {
+			scope.define(name, false, stmt.Position())
+		}
+	case *ast.LetsStmt:
+		c.exprs(stmt.RHSS, scope)
+		c.assigns(stmt.LHSS, scope)
+	case *ast.LetMapItemStmt:
+		c.expr(stmt.RHS, scope)
+		c.assigns(stmt.LHSS, scope)
+	case *ast.IfStmt:
+		c.expr(stmt.If, scope)
+		c.stmt(stmt.Then, newConstScope(scope))
+		for _, elseIf := range stmt.ElseIf {
+			elseIf := elseIf.(*ast.IfStmt)
+			c.expr(elseIf.If, newConstScope(scope))
+			c.stmt(elseIf.Then, newConstScope(scope))
+		}
+		c.stmt(stmt.Else, newConstScope(scope))
+	case *ast.TryStmt:
+		// the try, catch and finally blocks share their scope
+		scope = newConstScope(scope)
+		c.stmt(stmt.Try, scope)
+		if stmt.Var != "" {
+			scope.define(stmt.Var, false, stmt.Position())
+		}
+		c.stmt(stmt.Catch, scope)
+		c.stmt(stmt.Finally, scope)
+	case *ast.LoopStmt:
+		scope = newConstScope(scope)
+		c.expr(stmt.Expr, scope)
+		c.stmt(stmt.Stmt, scope)
+	case *ast.ForStmt:
+		c.expr(stmt.Value, scope)
+		scope = newConstScope(scope)
+		for _, name := range stmt.Vars {
+			scope.define(name, false, stmt.Position())
+		}
+		c.stmt(stmt.Stmt, scope)
+	case *ast.CForStmt:
+		scope = newConstScope(scope)
+		c.stmt(stmt.Stmt1, scope)
+		c.expr(stmt.Expr2, scope)
+		c.stmt(stmt.Stmt, scope)
+		c.expr(stmt.Expr3, scope)
+	case *ast.ReturnStmt:
+		c.exprs(stmt.Exprs, scope)
+	case *ast.ThrowStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.YieldStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.ModuleStmt:
+		scope.define(stmt.Name, false, stmt.Position())
+		c.stmt(stmt.Stmt, newConstScope(scope))
+	case *ast.SwitchStmt:
+		scope = newConstScope(scope)
+		c.expr(stmt.Expr, scope)
+		for _, switchCase := range stmt.Cases {
+			switchCase := switchCase.(*ast.SwitchCaseStmt)
+			c.exprs(switchCase.Exprs, scope)
+			c.stmt(switchCase.Stmt, scope)
+		}
+		c.stmt(stmt.Default, scope)
+	case *ast.TypeSwitchStmt:
+		scope = newConstScope(scope)
+		c.expr(stmt.Expr, scope)
+		for _, switchCase := range stmt.Cases {
+			c.stmt(switchCase.(*ast.TypeSwitchCaseStmt).Stmt, scope)
+		}
+		c.stmt(stmt.Default, scope)
+	case *ast.SelectStmt:
+		scope = newConstScope(scope)
+		for _, selectCase := range stmt.Cases {
+			selectCase := selectCase.(*ast.SelectCaseStmt)
+			c.expr(selectCase.Chan, scope)
+			c.expr(selectCase.Send, scope)
+			c.assign(selectCase.LHS, scope)
+			c.assign(selectCase.OkExpr, scope)
+			c.stmt(selectCase.Stmt, scope)
+		}
+		c.stmt(stmt.Default, scope)
+	case *ast.GoroutineStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.DeferStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.DeleteStmt:
+		c.expr(stmt.Item, scope)
+		c.expr(stmt.Key, scope)
+	case *ast.CloseStmt:
+		c.expr(stmt.Expr, scope)
+	case *ast.ChanStmt:
+		c.expr(stmt.RHS, scope)
+		c.assign(stmt.LHS, scope)
+		c.assign(stmt.OkExpr, scope)
+	}
+}
+
+func (c *constChecker) assigns(exprs []ast.Expr, scope *constScope) {
+	for _, expr := range exprs {
+		c.assign(expr, scope)
+	}
+}
+
+// assign checks the assignment to expr, which defines it in scope if it is a name not defined yet.
+func (c *constChecker) assign(expr ast.Expr, scope *constScope) {
+	ident, ok := expr.(*ast.IdentExpr)
+	if !ok {
+		c.expr(expr, scope)
+		return
+	}
+	def, ok := scope.lookup(ident.Lit)
+	if !ok {
+		scope.define(ident.Lit, false, ident.Position())
+		return
+	}
+	if def.isConst && positionAfter(ident.Position(), def.pos) && c.err == nil {
+		c.err = &Error{Message: "cannot assign to constant '" + ident.Lit + "'", Pos: ident.Position(), Fatal: true}
+	}
+}
+
+func (c *constChecker) exprs(exprs []ast.Expr, scope *constScope) {
+	for _, expr := range exprs {
+		c.expr(expr, scope)
+	}
+}
+
+func (c *constChecker) expr(expr ast.Expr, scope *constScope) {
+	if expr == nil || c.err != nil {
+		return
+	}
+	switch expr := expr.(type) {
+	case *ast.FuncExpr:
+		if expr.Name != "" && expr.Recv == "" {
+			scope.define(expr.Name, false, expr.Position())
+		}
+		c.funcs = append(c.funcs, constFunc{expr: expr, scope: scope})
+	case *ast.LetsExpr:
+		c.exprs(expr.RHSS, scope)
+		c.assigns(expr.LHSS, scope)
+	case *ast.OpExpr:
+		switch op := expr.Op.(type) {
+		case *ast.BinaryOperator:
+			c.expr(op.LHS, scope)
+			c.expr(op.RHS, scope)
+		case *ast.ComparisonOperator:
+			c.expr(op.LHS, scope)
+			c.expr(op.RHS, scope)
+		case *ast.AddOperator:
+			c.expr(op.LHS, scope)
+			c.expr(op.RHS, scope)
+		case *ast.MultiplyOperator:
+			c.expr(op.LHS, scope)
+			c.expr(op.RHS, scope)
+		}
+	case *ast.InterpolatedStringExpr:
+		c.exprs(expr.Exprs, scope)
+	case *ast.ArrayExpr:
+		c.exprs(expr.Exprs, scope)
+	case *ast.StructExpr:
+		for _, field := range expr.Fields {
+			c.expr(field.Expr, scope)
+		}
+	case *ast.MapExpr:
+		c.exprs(expr.Keys, scope)
+		c.exprs(expr.Values, scope)
+	case *ast.SpreadExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.UnaryExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.AddrExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.DerefExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.ParenExpr:
+		c.expr(expr.SubExpr, scope)
+	case *ast.NilCoalescingOpExpr:
+		c.expr(expr.LHS, scope)
+		c.expr(expr.RHS, scope)
+	case *ast.TernaryOpExpr:
+		c.expr(expr.Expr, scope)
+		c.expr(expr.LHS, scope)
+		c.expr(expr.RHS, scope)
+	case *ast.CallExpr:
+		c.exprs(expr.SubExprs, scope)
+	case *ast.CallErrExpr:
+		c.exprs(expr.SubExprs, scope)
+	case *ast.AnonCallExpr:
+		c.expr(expr.Expr, scope)
+		c.exprs(expr.SubExprs, scope)
+	case *ast.AnonCallErrExpr:
+		c.expr(expr.Expr, scope)
+		c.exprs(expr.SubExprs, scope)
+	case *ast.MemberExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.ItemExpr:
+		c.expr(expr.Item, scope)
+		c.expr(expr.Index, scope)
+	case *ast.SliceExpr:
+		c.expr(expr.Item, scope)
+		c.expr(expr.Begin, scope)
+		c.expr(expr.End, scope)
+		c.expr(expr.Cap, scope)
+	case *ast.ChanExpr:
+		c.expr(expr.LHS, scope)
+		c.expr(expr.RHS, scope)
+	case *ast.MakeExpr:
+		c.expr(expr.LenExpr, scope)
+		c.expr(expr.CapExpr, scope)
+	case *ast.MakeTypeExpr:
+		c.expr(expr.Type, scope)
+	case *ast.LenExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.IncludeExpr:
+		c.expr(expr.ItemExpr, scope)
+		c.expr(expr.ListExpr, scope)
+	case *ast.IsExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.TypeAssertExpr:
+		c.expr(expr.Expr, scope)
+	case *ast.MatchExpr:
+		c.expr(expr.Expr, scope)
+		for _, matchCase := range expr.Cases {
+			caseScope := newConstScope(scope)
+			for _, pattern := range matchCase.Patterns {
+				definePattern(pattern, caseScope)
+			}
+			c.expr(matchCase.Guard, caseScope)
+			c.expr(matchCase.Expr, caseScope)
+		}
+		c.expr(expr.Default, scope)
+	}
+}
+
+// definePattern defines the names bound by pattern in scope.
+func definePattern(pattern ast.Pattern, scope *constScope) {
+	switch pattern := pattern.(type) {
+	case *ast.BindPattern:
+		scope.define(pattern.Name, false, pattern.Position())
+	case *ast.TypePattern:
+		if pattern.Name != "" {
+			scope.define(pattern.Name, false, pattern.Position())
+		}
+	case *ast.SlicePattern:
+		for _, item := range pattern.Items {
+			definePattern(item, scope)
+		}
+		if pattern.HasRest {
+			scope.define(pattern.Rest, false, pattern.Position())
+		}
+	case *ast.MapPattern:
+		for _, value := range pattern.Values {
+			definePattern(value, scope)
+		}
+	case *ast.StructPattern:
+		for _, value := range pattern.Values {
+			definePattern(value, scope)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/dgrr/pako/ast"
 )
@@ -38,42 +39,54 @@ type Scanner struct {
 	offset   int
 	lineHead int
 	line     int
+	// inPattern is true while the patterns of a match case are scanned, where .. is a range.
+	inPattern bool
+	// arrows are the results of arrowFollows by the offsets after the ( already checked.
+	arrows map[int]bool
 }
 
 // opName is correction of operation names.
 var opName = map[string]int{
-	"fn":       FUNC,
-	"return":   RETURN,
-	"var":      VAR,
-	"throw":    THROW,
-	"if":       IF,
-	"for":      FOR,
-	"break":    BREAK,
-	"continue": CONTINUE,
-	"in":       IN,
-	"else":     ELSE,
-	"new":      NEW,
-	"true":     TRUE,
-	"false":    FALSE,
-	"nil":      NIL,
-	"module":   MODULE,
-	"try":      TRY,
-	"catch":    CATCH,
-	"finally":  FINALLY,
-	"switch":   SWITCH,
-	"case":     CASE,
-	"default":  DEFAULT,
-	"go":       GO,
-	"chan":     CHAN,
-	"struct":   STRUCT,
-	"make":     MAKE,
-	"type":     TYPE,
-	"len":      LEN,
-	"delete":   DELETE,
-	"close":    CLOSE,
-	"map":      MAP,
-	"import":   IMPORT,
-	"as":       AS,
+	"fn":        FUNC,
+	"return":    RETURN,
+	"var":       VAR,
+	"const":     CONST,
+	"enum":      ENUM,
+	"throw":     THROW,
+	"yield":     YIELD,
+	"if":        IF,
+	"for":       FOR,
+	"break":     BREAK,
+	"continue":  CONTINUE,
+	"in":        IN,
+	"else":      ELSE,
+	"new":       NEW,
+	"true":      TRUE,
+	"false":     FALSE,
+	"nil":       NIL,
+	"module":    MODULE,
+	"try":       TRY,
+	"catch":     CATCH,
+	"finally":   FINALLY,
+	"switch":    SWITCH,
+	"match":     MATCH,
+	"select":    SELECT,
+	"case":      CASE,
+	"default":   DEFAULT,
+	"defer":     DEFER,
+	"go":        GO,
+	"chan":      CHAN,
+	"struct":    STRUCT,
+	"interface": INTERFACE,
+	"is":        IS,
+	"make":      MAKE,
+	"type":      TYPE,
+	"len":       LEN,
+	"delete":    DELETE,
+	"close":     CLOSE,
+	"map":       MAP,
+	"import":    IMPORT,
+	"as":        AS,
 }
 
 var (
@@ -83,9 +96,68 @@ var (
 	oneLiteral = &ast.LiteralExpr{Literal: reflect.ValueOf(int64(1))}
 )
 
+// arrowFollows returns true if the ( just scanned is closed by a ) followed by =>,
+// so it starts the parameters of an arrow function.
+// The parentheses inside it are checked by the same scan, so each part of the source is scanned once.
+func (s *Scanner) arrowFollows() bool {
+	if arrow, ok := s.arrows[s.offset]; ok {
+		return arrow
+	}
+	if s.arrows == nil {
+		s.arrows = make(map[int]bool)
+	}
+	c := *s
+	open := []int{s.offset}
+	for len(open) > 0 {
+		tok, _, _, err := c.Scan()
+		if err != nil {
+			tok = EOF
+		}
+		switch tok {
+		case '(':
+			open = append(open, c.offset)
+		case ')':
+			next := c
+			tok, _, _, err = next.Scan()
+			s.arrows[open[len(open)-1]] = err == nil && tok == ARROW
+			open = open[:len(open)-1]
+		case EOF, ';', '{', '}':
+			for _, offset := range open {
+				s.arrows[offset] = false
+			}
+			open = nil
+		}
+	}
+	return s.arrows[s.offset]
+}
+
+// blockFollows returns true if the { just scanned is closed by a } followed by another {,
+// so in a statement head it starts a literal before the block of the statement.
+func (s *Scanner) blockFollows() bool {
+	c := *s
+	depth := 1
+	for depth > 0 {
+		tok, _, _, err := c.Scan()
+		if err != nil {
+			return false
+		}
+		switch tok {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case EOF:
+			return false
+		}
+	}
+	tok, _, _, err := c.Scan()
+	return err == nil && tok == '{'
+}
+
 // Init resets code to scan.
 func (s *Scanner) Init(src string) {
 	s.src = []rune(src)
+	s.arrows = nil
 }
 
 // Scan analyses token, and decide identify or literals.
@@ -94,12 +166,20 @@ retry:
 	s.skipBlank()
 	pos = s.pos()
 	switch ch := s.peek(); {
+	case ch == 'f' && (s.peekPlus(1) == '"' || s.peekPlus(1) == '\''):
+		tok = FSTRING
+		s.next()
+		lit, err = s.scanInterpolatedString(s.peek())
+		if err != nil {
+			return
+		}
 	case isLetter(ch):
 		lit, err = s.scanIdentifier()
 		if err != nil {
 			return
 		}
-		if name, ok := opName[lit]; ok {
+		if name, ok := opName[lit]; ok && ((name != IS && name != MATCH) || s.peekNonBlank() != '(') {
+			// is and match followed by ( are calls, so they can still be used as function names
 			tok = name
 		} else {
 			tok = IDENT
@@ -154,6 +234,9 @@ retry:
 			case '=':
 				tok = EQEQ
 				lit = "=="
+			case '>':
+				tok = ARROW
+				lit = "=>"
 			case ' ':
 				if s.peekPlus(1) == '<' && s.peekPlus(2) == '-' {
 					s.next()
@@ -315,9 +398,13 @@ retry:
 				s.next()
 				if s.peek() == '.' {
 					tok = VARARG
-				} else {
+				} else if !s.inPattern {
 					err = fmt.Errorf("syntax error on '%v' at %v:%v", string(ch), pos.Line, pos.Column)
 					return
+				} else {
+					s.back()
+					tok = RANGE
+					lit = ".."
 				}
 			} else {
 				s.back()
@@ -343,6 +430,12 @@ func isLetter(ch rune) bool {
 	return unicode.IsLetter(ch) || ch == '_'
 }
 
+// isLowerName returns true if the first rune of the name is a lowercase letter.
+func isLowerName(name string) bool {
+	ch, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsLower(ch)
+}
+
 // isDigit returns true if the rune is a number.
 func isDigit(ch rune) bool {
 	return '0' <= ch && ch <= '9'
@@ -379,6 +472,15 @@ func (s *Scanner) peekPlus(i int) rune {
 	return s.src[s.offset+i]
 }
 
+// peekNonBlank returns the next non-blank rune in the code, without moving the offset.
+func (s *Scanner) peekNonBlank() rune {
+	i := 0
+	for isBlank(s.peekPlus(i)) {
+		i++
+	}
+	return s.peekPlus(i)
+}
+
 // next moves offset to next.
 func (s *Scanner) next() {
 	if !s.reachEOF() {
@@ -459,6 +561,10 @@ func (s *Scanner) scanNumber() (string, error) {
 			}
 
 			if s.peek() == '.' {
+				if s.inPattern && s.peekPlus(1) == '.' {
+					// range, not part of the number
+					break
+				}
 				// is .
 				result = append(result, '.')
 				s.next()
@@ -528,24 +634,7 @@ eos:
 			break eos
 		case '\\':
 			s.next()
-			switch s.peek() {
-			case 'b':
-				ret = append(ret, '\b')
-				continue
-			case 'f':
-				ret = append(ret, '\f')
-				continue
-			case 'r':
-				ret = append(ret, '\r')
-				continue
-			case 'n':
-				ret = append(ret, '\n')
-				continue
-			case 't':
-				ret = append(ret, '\t')
-				continue
-			}
-			ret = append(ret, s.peek())
+			ret = append(ret, unescape(s.peek()))
 			continue
 		default:
 			ret = append(ret, s.peek())
@@ -554,14 +643,132 @@ eos:
 	return string(ret), nil
 }
 
+// unescape returns the rune of the escape sequence with a backslash followed by ch.
+func unescape(ch rune) rune {
+	switch ch {
+	case 'b':
+		return '\b'
+	case 'f':
+		return '\f'
+	case 'r':
+		return '\r'
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	}
+	return ch
+}
+
+// scanInterpolatedString returns interpolated string starting at current position.
+// Escapes and interpolations are kept as they are, so quotes inside ${} do not end the string.
+func (s *Scanner) scanInterpolatedString(l rune) (string, error) {
+	var ret []rune
+	for {
+		s.next()
+		switch s.peek() {
+		case EOL:
+			return "", errors.New("unexpected EOL")
+		case EOF:
+			return "", errors.New("unexpected EOF")
+		case l:
+			s.next()
+			return string(ret), nil
+		case '\\':
+			ret = append(ret, '\\')
+			s.next()
+			switch s.peek() {
+			case EOL:
+				return "", errors.New("unexpected EOL")
+			case EOF:
+				return "", errors.New("unexpected EOF")
+			}
+		case '$':
+			if s.peekPlus(1) != '{' {
+				break
+			}
+			end := interpolationEnd(s.src, s.offset+2)
+			if end < 0 {
+				// skip the rest of the line, it can not be scanned as code
+				for !isEOL(s.peek()) {
+					s.next()
+				}
+				return "", errors.New("unterminated interpolation")
+			}
+			ret = append(ret, s.src[s.offset:end]...)
+			s.set(end)
+		}
+		ret = append(ret, s.peek())
+	}
+}
+
+// interpolationEnd returns the offset of the } closing the interpolation whose expression starts at i,
+// skipping nested braces and string literals. It returns -1 if the interpolation does not end in the line.
+func interpolationEnd(src []rune, i int) int {
+	depth := 1
+	for ; i < len(src); i++ {
+		switch src[i] {
+		case EOL:
+			return -1
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '"', '\'', '`':
+			quote := src[i]
+			for i++; i < len(src) && src[i] != quote; i++ {
+				if src[i] == EOL {
+					return -1
+				}
+				if src[i] == '\\' && quote != '`' && i+1 < len(src) && src[i+1] != EOL {
+					i++
+				}
+			}
+			if i >= len(src) {
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
 // Lexer provides interface to parse codes.
 type Lexer struct {
 	opts *ParserOpts
 	s    *Scanner
+	tok  int
 	lit  string
 	pos  ast.Position
 	e    error
 	stmt ast.Stmt
+	// prevTok is the token before tok.
+	prevTok int
+	// depth is the number of open parentheses, brackets and braces.
+	depth int
+	// heads are the if, for, switch and match statements whose block has not started yet.
+	heads []head
+	// matches are the depths inside the blocks of the match expressions being scanned.
+	matches []int
+	// yields are the positions of the yield statements not yet assigned to a function.
+	yields []ast.Position
+	// labels are the break and continue statements with labels not yet assigned to a labeled statement.
+	labels []labelRef
+}
+
+// head is the first token of a statement whose block has not started yet and the depth of the statement.
+type head struct {
+	tok   int
+	depth int
+}
+
+// labelRef is a break or continue statement with a label.
+type labelRef struct {
+	label      string
+	pos        ast.Position
+	isContinue bool
 }
 
 // Lex scans the token and literals.
@@ -583,13 +790,135 @@ func (l *Lexer) Lex(lval *yySymType) int {
 		}
 	}
 
+	if tok == FSTRING {
+		lval.expr = l.interpolatedString(lit, pos)
+	}
+	switch {
+	case tok == '(' && l.s.arrowFollows():
+		tok = ARROWPAREN
+	case tok == '{' && l.tok == ARROW:
+		// the body of an arrow function, so x => {"a": x} must be written as x => ({"a": x})
+		tok = ARROWBRACE
+	}
+	inMatch := len(l.matches) > 0 && l.matches[len(l.matches)-1] == l.depth
+	switch tok {
+	case IF:
+		if l.s.inPattern && inMatch {
+			// the guard of a match case
+			l.s.inPattern = false
+			break
+		}
+		l.heads = append(l.heads, head{tok: tok, depth: l.depth})
+	case FOR, SWITCH, MATCH:
+		l.heads = append(l.heads, head{tok: tok, depth: l.depth})
+	case CASE:
+		l.s.inPattern = inMatch
+	case ':':
+		if inMatch {
+			l.s.inPattern = false
+		}
+	case '(', '[', ARROWPAREN, ARROWBRACE:
+		l.depth++
+	case ')', ']', '}':
+		l.depth--
+		if len(l.matches) > 0 && l.matches[len(l.matches)-1] > l.depth {
+			l.matches = l.matches[:len(l.matches)-1]
+		}
+	case '{':
+		if len(l.heads) > 0 && l.heads[len(l.heads)-1].depth == l.depth && (l.tok == FOR || l.tok != l.heads[len(l.heads)-1].tok) {
+			// like in Go, the first { of a statement head outside of parentheses starts its block,
+			// so if a { } is not taken as a literal, but for v in []T{} { } and if (a == T{}) { } are.
+			// A { right after if, switch or match starts a map literal.
+			h := l.heads[len(l.heads)-1]
+			l.heads = l.heads[:len(l.heads)-1]
+			if l.tok == IDENT && l.prevTok == ']' && l.s.blockFollows() {
+				tok = LITBRACE
+				l.heads = append(l.heads, h)
+			} else if h.tok == MATCH {
+				l.matches = append(l.matches, l.depth+1)
+			}
+		} else if l.tok == IDENT {
+			// outside of statement heads, a { after a name starts a struct literal, as in Point{X: 1},
+			// or the body of a struct, enum, interface, module or catch, which accept both braces
+			tok = LITBRACE
+		}
+		l.depth++
+	}
+
 	lval.tok = ast.Token{Tok: tok, Lit: lit}
 	lval.tok.SetPosition(pos)
+	l.prevTok = l.tok
+	l.tok = tok
 	l.lit = lit
 	l.pos = pos
 	return tok
 }
 
+// interpolatedString returns the interpolated string expression of the f-string lit starting at pos.
+// Each interpolation is parsed as an expression, with the positions of its code inside the string.
+func (l *Lexer) interpolatedString(lit string, pos ast.Position) ast.Expr {
+	expr := &ast.InterpolatedStringExpr{}
+	expr.SetPosition(pos)
+
+	src := []rune(lit)
+	// the f-string is in a single line and its code starts after f and the quote
+	column := pos.Column + 2
+	var ret []rune
+	for i := 0; i < len(src); i++ {
+		switch {
+		case src[i] == '\\':
+			i++
+			ret = append(ret, unescape(src[i]))
+			continue
+		case src[i] == '$' && i+1 < len(src) && src[i+1] == '{':
+			end := interpolationEnd(src, i+2)
+			exprPos := ast.Position{Line: pos.Line, Column: column + i + 2}
+			subExpr, err := l.parseInterpolation(src[i+2:end], exprPos)
+			if err != nil {
+				l.e = err
+				expr.Strings = append(expr.Strings, string(ret))
+				return expr
+			}
+			expr.Strings = append(expr.Strings, string(ret))
+			expr.Exprs = append(expr.Exprs, subExpr)
+			ret = nil
+			i = end
+			continue
+		}
+		ret = append(ret, src[i])
+	}
+	expr.Strings = append(expr.Strings, string(ret))
+	return expr
+}
+
+// parseInterpolation parses the code src of an interpolation starting at pos, which must be a single expression.
+func (l *Lexer) parseInterpolation(src []rune, pos ast.Position) (ast.Expr, error) {
+	scanner := &Scanner{
+		src:      src,
+		line:     pos.Line - 1,
+		lineHead: 1 - pos.Column,
+	}
+	stmt, err := ParseWith(scanner, l.opts)
+	if err != nil {
+		if e, ok := err.(*Error); ok {
+			// the string is closed, so more code can not fix the error
+			e.Fatal = true
+		}
+		return nil, err
+	}
+	if stmts, ok := stmt.(*ast.StmtsStmt); ok && len(stmts.Stmts) == 1 {
+		stmt = stmts.Stmts[0]
+	}
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		if stmt == nil {
+			return nil, &Error{Message: "empty interpolation", Pos: pos, Fatal: true}
+		}
+		return nil, &Error{Message: "interpolation must be an expression", Pos: stmt.Position(), Fatal: true}
+	}
+	return exprStmt.Expr, nil
+}
+
 // Error sets parse error.
 func (l *Lexer) Error(msg string) {
 	l.e = &Error{Message: msg, Pos: l.pos, Fatal: false}
@@ -606,9 +935,117 @@ func ParseWith(s *Scanner, opts *ParserOpts) (ast.Stmt, error) {
 	if yyParse(&l) != 0 {
 		return nil, l.e
 	}
+	if l.e == nil && len(l.yields) > 0 {
+		return nil, &Error{Message: "yield outside of function", Pos: l.yields[0], Fatal: true}
+	}
+	if l.e == nil && len(l.labels) > 0 {
+		return nil, undefinedLabel(l.labels[0])
+	}
+	if l.e == nil {
+		if err := checkConsts(l.stmt); err != nil {
+			return nil, err
+		}
+	}
 	return l.stmt, l.e
 }
 
+// isGenerator returns true if the function starting at fn has yield statements.
+// Functions are reduced after their bodies, so the yield statements after fn are
+// the ones of the function, as the ones of nested functions have been already assigned.
+func isGenerator(yylex yyLexer, fn ast.Token) bool {
+	l, ok := yylex.(*Lexer)
+	if !ok {
+		return false
+	}
+	pos := fn.Position()
+	i := len(l.yields)
+	for i > 0 && positionAfter(l.yields[i-1], pos) {
+		i--
+	}
+	generator := i < len(l.yields)
+	l.yields = l.yields[:i]
+	return generator
+}
+
+// addLabelRef records the break or continue statement at tok with label, to be checked by the labeled statements.
+func addLabelRef(yylex yyLexer, tok ast.Token, label string, isContinue bool) {
+	if l, ok := yylex.(*Lexer); ok {
+		l.labels = append(l.labels, labelRef{label: label, pos: tok.Position(), isContinue: isContinue})
+	}
+}
+
+// setLabel sets the label of the loop, switch or select statement stmt starting at the label token.
+// It assigns to it the break and continue statements with its label after the label token.
+// Like yield statements, they are reduced before the labeled statement, so the ones of nested statements
+// with the same label have been already assigned.
+func setLabel(yylex yyLexer, label ast.Token, stmt ast.Stmt) bool {
+	loop := true
+	switch stmt := stmt.(type) {
+	case *ast.LoopStmt:
+		stmt.Label = label.Lit
+	case *ast.ForStmt:
+		stmt.Label = label.Lit
+	case *ast.CForStmt:
+		stmt.Label = label.Lit
+	case *ast.SwitchStmt:
+		stmt.Label = label.Lit
+		loop = false
+	case *ast.TypeSwitchStmt:
+		stmt.Label = label.Lit
+		loop = false
+	case *ast.SelectStmt:
+		stmt.Label = label.Lit
+		loop = false
+	}
+
+	l, ok := yylex.(*Lexer)
+	if !ok {
+		return true
+	}
+	pos := label.Position()
+	labels := l.labels[:0]
+	for _, ref := range l.labels {
+		if ref.label != label.Lit || !positionAfter(ref.pos, pos) {
+			labels = append(labels, ref)
+			continue
+		}
+		if ref.isContinue && !loop {
+			l.e = &Error{Message: "invalid continue label " + ref.label, Pos: ref.pos, Fatal: true}
+			return false
+		}
+	}
+	l.labels = labels
+	return true
+}
+
+// labelsDefined returns false if the function starting at fn has break or continue statements with undefined labels,
+// as they cannot break the loops of the enclosing functions.
+func labelsDefined(yylex yyLexer, fn ast.Token) bool {
+	l, ok := yylex.(*Lexer)
+	if !ok {
+		return true
+	}
+	for _, ref := range l.labels {
+		if positionAfter(ref.pos, fn.Position()) {
+			l.e = undefinedLabel(ref)
+			return false
+		}
+	}
+	return true
+}
+
+func undefinedLabel(ref labelRef) *Error {
+	if ref.isContinue {
+		return &Error{Message: "continue label not defined: " + ref.label, Pos: ref.pos, Fatal: true}
+	}
+	return &Error{Message: "break label not defined: " + ref.label, Pos: ref.pos, Fatal: true}
+}
+
+// positionAfter returns true if a is after b.
+func positionAfter(a, b ast.Position) bool {
+	return a.Line > b.Line || a.Line == b.Line && a.Column > b.Column
+}
+
 // EnableErrorVerbose enabled verbose errors from the parser
 func EnableErrorVerbose() {
 	yyErrorVerbose = true
@@ -687,3 +1124,73 @@ func toNumber(numString string) (reflect.Value, error) {
 func stringToValue(aString string) reflect.Value {
 	return reflect.ValueOf(aString)
 }
+
+// patternLiteral returns the value of the literal expression expr of a pattern.
+func patternLiteral(yylex yyLexer, expr ast.Expr) (reflect.Value, bool) {
+	switch expr := expr.(type) {
+	case *ast.LiteralExpr:
+		return expr.Literal, true
+	case *ast.InterpolatedStringExpr:
+		yylex.Error("interpolated strings are not allowed in patterns")
+	default:
+		yylex.Error("patterns can only have literal values")
+	}
+	return nilValue, false
+}
+
+// isRangeBounds returns true if from and to can be the bounds of a range pattern, both numbers or both strings.
+func isRangeBounds(from reflect.Value, to reflect.Value) bool {
+	switch from.Kind() {
+	case reflect.Int64, reflect.Float64:
+		return to.Kind() == reflect.Int64 || to.Kind() == reflect.Float64
+	case reflect.String:
+		return to.Kind() == reflect.String
+	}
+	return false
+}
+
+// duplicateName returns the first name repeated in names, or an empty string if there is none.
+func duplicateName(names []string) string {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if seen[name] {
+			return name
+		}
+		seen[name] = true
+	}
+	return ""
+}
+
+// structLiteralType returns the type of a struct literal with the type name expr, like Point or pkg.Point.
+// It returns nil after reporting an error if expr is not a type name.
+func structLiteralType(yylex yyLexer, expr ast.Expr) *ast.TypeStruct {
+	var names []string
+	for {
+		switch e := expr.(type) {
+		case *ast.IdentExpr:
+			names = append([]string{e.Lit}, names...)
+			return &ast.TypeStruct{Env: names[:len(names)-1], Name: names[len(names)-1]}
+		case *ast.MemberExpr:
+			names = append([]string{e.Name}, names...)
+			expr = e.Expr
+		default:
+			yylex.Error("syntax error: invalid struct literal type")
+			return nil
+		}
+	}
+}
+
+// lambdaParams returns the names of the parameters of an arrow function, written as exprs between parentheses.
+// It returns false after reporting an error if one of them is not a name.
+func lambdaParams(yylex yyLexer, exprs []ast.Expr) ([]string, bool) {
+	params := make([]string, len(exprs))
+	for i, expr := range exprs {
+		ident, ok := expr.(*ast.IdentExpr)
+		if !ok {
+			yylex.Error("syntax error: arrow function parameters must be names")
+			return nil, false
+		}
+		params[i] = ident.Lit
+	}
+	return params, true
+}
@@ -8,38 +8,51 @@ import __yyfmt__ "fmt"
 //line parser.go.y:2
 
 import (
+	"reflect"
+
 	"github.com/dgrr/pako/ast"
 )
 
-//line parser.go.y:50
+//line parser.go.y:78
 type yySymType struct {
 	yys int
 	tok ast.Token
 
-	compstmt            ast.Stmt
-	modstmts            ast.Stmt
-	modstmt             ast.Stmt
-	stmts               ast.Stmt
-	stmt                ast.Stmt
-	stmt_var_or_lets    ast.Stmt
-	stmt_import         ast.Stmt
-	stmt_module         ast.Stmt
-	stmt_struct         ast.Stmt
-	stmt_var            ast.Stmt
-	stmt_lets           ast.Stmt
-	stmt_if             ast.Stmt
-	stmt_for            ast.Stmt
-	stmt_switch         ast.Stmt
-	stmt_switch_cases   ast.Stmt
-	stmt_switch_case    ast.Stmt
-	stmt_switch_default ast.Stmt
+	compstmt               ast.Stmt
+	modstmts               ast.Stmt
+	modstmt                ast.Stmt
+	stmts                  ast.Stmt
+	stmt                   ast.Stmt
+	stmt_var_or_lets       ast.Stmt
+	stmt_import            ast.Stmt
+	stmt_module            ast.Stmt
+	stmt_struct            ast.Stmt
+	stmt_interface         ast.Stmt
+	interface_methods      []*ast.InterfaceMethod
+	interface_method       *ast.InterfaceMethod
+	stmt_var               ast.Stmt
+	stmt_lets              ast.Stmt
+	stmt_if                ast.Stmt
+	stmt_for               ast.Stmt
+	stmt_switch            ast.Stmt
+	stmt_switch_cases      ast.Stmt
+	stmt_switch_case       ast.Stmt
+	stmt_switch_default    ast.Stmt
+	stmt_select            ast.Stmt
+	stmt_select_cases      ast.Stmt
+	stmt_select_case       ast.Stmt
+	stmt_type_switch_cases ast.Stmt
+	stmt_type_switch_case  ast.Stmt
 
 	exprs                []ast.Expr
 	expr                 ast.Expr
 	expr_idents          []string
 	type_data            *ast.TypeStruct
 	type_data_struct     *ast.TypeStruct
+	type_datas           []*ast.TypeStruct
 	slice_count          int
+	struct_tag           string
+	struct_fields        []*ast.StructExprField
 	expr_member_or_ident ast.Expr
 	expr_member          *ast.MemberExpr
 	expr_ident           *ast.IdentExpr
@@ -51,6 +64,11 @@ type yySymType struct {
 	expr_binary          ast.Expr
 	expr_lets            ast.Expr
 
+	match_cases *ast.MatchExpr
+	match_case  *ast.MatchCase
+	patterns    []ast.Pattern
+	pattern     ast.Pattern
+
 	op_binary     ast.Operator
 	op_comparison ast.Operator
 	op_add        ast.Operator
@@ -66,54 +84,68 @@ const FUNC = 57351
 const RETURN = 57352
 const VAR = 57353
 const THROW = 57354
-const IF = 57355
-const ELSE = 57356
-const FOR = 57357
-const IN = 57358
-const EQEQ = 57359
-const NEQ = 57360
-const GE = 57361
-const LE = 57362
-const OROR = 57363
-const ANDAND = 57364
-const NEW = 57365
-const TRUE = 57366
-const FALSE = 57367
-const NIL = 57368
-const NILCOALESCE = 57369
-const MODULE = 57370
-const TRY = 57371
-const CATCH = 57372
-const FINALLY = 57373
-const PLUSEQ = 57374
-const MINUSEQ = 57375
-const MULEQ = 57376
-const DIVEQ = 57377
-const ANDEQ = 57378
-const OREQ = 57379
-const BREAK = 57380
-const CONTINUE = 57381
-const PLUSPLUS = 57382
-const MINUSMINUS = 57383
-const SHIFTLEFT = 57384
-const SHIFTRIGHT = 57385
-const SWITCH = 57386
-const CASE = 57387
-const DEFAULT = 57388
-const GO = 57389
-const CHAN = 57390
-const STRUCT = 57391
-const MAKE = 57392
-const OPCHAN = 57393
-const EQOPCHAN = 57394
-const TYPE = 57395
-const LEN = 57396
-const DELETE = 57397
-const CLOSE = 57398
-const MAP = 57399
-const IMPORT = 57400
-const AS = 57401
-const UNARY = 57402
+const YIELD = 57355
+const IF = 57356
+const ELSE = 57357
+const FOR = 57358
+const IN = 57359
+const EQEQ = 57360
+const NEQ = 57361
+const GE = 57362
+const LE = 57363
+const OROR = 57364
+const ANDAND = 57365
+const NEW = 57366
+const TRUE = 57367
+const FALSE = 57368
+const NIL = 57369
+const NILCOALESCE = 57370
+const MODULE = 57371
+const TRY = 57372
+const CATCH = 57373
+const FINALLY = 57374
+const PLUSEQ = 57375
+const MINUSEQ = 57376
+const MULEQ = 57377
+const DIVEQ = 57378
+const ANDEQ = 57379
+const OREQ = 57380
+const BREAK = 57381
+const CONTINUE = 57382
+const PLUSPLUS = 57383
+const MINUSMINUS = 57384
+const SHIFTLEFT = 57385
+const SHIFTRIGHT = 57386
+const SWITCH = 57387
+const SELECT = 57388
+const CASE = 57389
+const DEFAULT = 57390
+const GO = 57391
+const DEFER = 57392
+const CHAN = 57393
+const STRUCT = 57394
+const INTERFACE = 57395
+const IS = 57396
+const MAKE = 57397
+const OPCHAN = 57398
+const EQOPCHAN = 57399
+const TYPE = 57400
+const LEN = 57401
+const DELETE = 57402
+const CLOSE = 57403
+const MAP = 57404
+const IMPORT = 57405
+const AS = 57406
+const MATCH = 57407
+const RANGE = 57408
+const CONST = 57409
+const ENUM = 57410
+const LITBRACE = 57411
+const ARROW = 57412
+const ARROWPAREN = 57413
+const ARROWBRACE = 57414
+const FSTRING = 57415
+const UNARY = 57416
 
 var yyToknames = [...]string{
 	"$end",
@@ -128,6 +160,7 @@ var yyToknames = [...]string{
 	"RETURN",
 	"VAR",
 	"THROW",
+	"YIELD",
 	"IF",
 	"ELSE",
 	"FOR",
@@ -160,11 +193,15 @@ var yyToknames = [...]string{
 	"SHIFTLEFT",
 	"SHIFTRIGHT",
 	"SWITCH",
+	"SELECT",
 	"CASE",
 	"DEFAULT",
 	"GO",
+	"DEFER",
 	"CHAN",
 	"STRUCT",
+	"INTERFACE",
+	"IS",
 	"MAKE",
 	"OPCHAN",
 	"EQOPCHAN",
@@ -175,6 +212,16 @@ var yyToknames = [...]string{
 	"MAP",
 	"IMPORT",
 	"AS",
+	"MATCH",
+	"RANGE",
+	"CONST",
+	"ENUM",
+	"LITBRACE",
+	"ARROW",
+	"ARROWPAREN",
+	"ARROWBRACE",
+	"FSTRING",
+	"'('",
 	"'='",
 	"':'",
 	"'?'",
@@ -189,809 +236,1180 @@ var yyToknames = [...]string{
 	"'%'",
 	"'&'",
 	"UNARY",
+	"'.'",
+	"'['",
 	"'{'",
 	"'}'",
-	"'('",
 	"')'",
 	"','",
-	"'.'",
 	"';'",
-	"'['",
 	"']'",
 	"'!'",
 	"'\\n'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line parser.go.y:1289
+//line parser.go.y:1980
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 2,
-	52, 77,
-	59, 77,
-	60, 77,
-	78, 77,
-	80, 13,
+	57, 118,
+	64, 118,
+	75, 118,
+	94, 118,
+	95, 16,
 	-2, 1,
-	-1, 25,
-	59, 78,
-	78, 78,
-	-2, 36,
-	-1, 30,
-	16, 120,
-	-2, 77,
-	-1, 71,
-	52, 77,
-	59, 77,
-	60, 77,
-	78, 77,
-	-2, 13,
-	-1, 126,
-	16, 121,
-	77, 121,
-	78, 121,
-	-2, 137,
-	-1, 134,
-	4, 132,
-	48, 132,
-	49, 132,
-	57, 132,
-	-2, 91,
-	-1, 270,
-	75, 7,
-	80, 7,
-	84, 7,
-	-2, 77,
-	-1, 289,
-	75, 209,
-	82, 209,
-	-2, 198,
-	-1, 310,
-	75, 209,
-	-2, 198,
-	-1, 315,
-	1, 80,
-	8, 80,
-	45, 80,
-	46, 80,
-	52, 80,
-	59, 80,
-	60, 80,
-	61, 80,
-	75, 80,
-	77, 80,
-	78, 80,
-	80, 80,
-	82, 80,
-	84, 80,
-	-2, 135,
-	-1, 318,
-	1, 25,
-	45, 25,
-	46, 25,
-	75, 25,
-	80, 25,
-	84, 25,
-	-2, 97,
-	-1, 320,
-	1, 27,
-	45, 27,
-	46, 27,
-	75, 27,
-	80, 27,
-	84, 27,
-	-2, 101,
-	-1, 331,
-	75, 7,
-	80, 7,
-	84, 7,
-	-2, 77,
-	-1, 335,
-	59, 78,
-	78, 78,
-	-2, 9,
+	-1, 32,
+	64, 119,
+	94, 119,
+	-2, 53,
+	-1, 37,
+	17, 173,
+	-2, 118,
+	-1, 84,
+	57, 118,
+	64, 118,
+	75, 118,
+	94, 118,
+	-2, 16,
+	-1, 145,
+	8, 295,
+	-2, 286,
+	-1, 150,
+	17, 174,
+	93, 174,
+	94, 174,
+	-2, 201,
+	-1, 163,
+	4, 196,
+	51, 196,
+	52, 196,
+	53, 196,
+	62, 196,
+	-2, 139,
+	-1, 254,
+	8, 296,
+	-2, 289,
+	-1, 334,
+	92, 7,
+	95, 7,
+	98, 7,
+	-2, 118,
+	-1, 340,
+	8, 295,
+	-2, 286,
 	-1, 363,
-	75, 207,
-	82, 207,
+	92, 306,
+	96, 306,
+	-2, 295,
+	-1, 384,
+	92, 306,
+	-2, 295,
+	-1, 390,
+	1, 121,
+	8, 121,
+	47, 121,
+	48, 121,
+	57, 121,
+	64, 121,
+	75, 121,
+	76, 121,
+	92, 121,
+	93, 121,
+	94, 121,
+	95, 121,
+	98, 121,
 	-2, 199,
-	-1, 385,
-	1, 24,
-	45, 24,
-	46, 24,
-	75, 24,
-	80, 24,
-	84, 24,
-	-2, 95,
-	-1, 386,
-	1, 26,
-	45, 26,
-	46, 26,
-	75, 26,
-	80, 26,
-	84, 26,
-	-2, 99,
-	-1, 421,
-	75, 199,
-	-2, 204,
+	-1, 396,
+	1, 34,
+	47, 34,
+	48, 34,
+	92, 34,
+	95, 34,
+	98, 34,
+	-2, 145,
+	-1, 398,
+	1, 36,
+	47, 36,
+	48, 36,
+	92, 36,
+	95, 36,
+	98, 36,
+	-2, 149,
+	-1, 400,
+	1, 38,
+	47, 38,
+	48, 38,
+	92, 38,
+	95, 38,
+	98, 38,
+	-2, 145,
+	-1, 402,
+	1, 40,
+	47, 40,
+	48, 40,
+	92, 40,
+	95, 40,
+	98, 40,
+	-2, 149,
+	-1, 414,
+	92, 306,
+	-2, 295,
+	-1, 417,
+	92, 306,
+	-2, 295,
+	-1, 419,
+	92, 7,
+	95, 7,
+	98, 7,
+	-2, 118,
+	-1, 423,
+	64, 119,
+	94, 119,
+	-2, 9,
+	-1, 436,
+	8, 295,
+	-2, 286,
+	-1, 438,
+	8, 295,
+	-2, 286,
+	-1, 467,
+	92, 304,
+	96, 304,
+	-2, 296,
+	-1, 489,
+	1, 33,
+	47, 33,
+	48, 33,
+	92, 33,
+	95, 33,
+	98, 33,
+	-2, 143,
+	-1, 490,
+	1, 35,
+	47, 35,
+	48, 35,
+	92, 35,
+	95, 35,
+	98, 35,
+	-2, 147,
+	-1, 491,
+	1, 37,
+	47, 37,
+	48, 37,
+	92, 37,
+	95, 37,
+	98, 37,
+	-2, 143,
+	-1, 492,
+	1, 39,
+	47, 39,
+	48, 39,
+	92, 39,
+	95, 39,
+	98, 39,
+	-2, 147,
+	-1, 511,
+	8, 295,
+	-2, 286,
+	-1, 532,
+	92, 306,
+	-2, 295,
+	-1, 542,
+	92, 296,
+	-2, 301,
+	-1, 696,
+	96, 306,
+	-2, 295,
+	-1, 700,
+	92, 306,
+	-2, 295,
+	-1, 712,
+	92, 306,
+	-2, 295,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 4180
-
-var yyAct = [...]int{
-
-	75, 290, 38, 25, 247, 10, 24, 7, 351, 333,
-	352, 356, 41, 15, 73, 77, 23, 8, 80, 2,
-	122, 4, 134, 70, 8, 71, 137, 364, 354, 353,
-	120, 123, 127, 128, 332, 229, 125, 449, 366, 5,
-	146, 140, 1, 8, 5, 310, 8, 411, 8, 289,
-	235, 8, 357, 8, 229, 8, 162, 308, 148, 144,
-	145, 152, 163, 164, 165, 166, 167, 8, 153, 232,
-	478, 88, 25, 155, 91, 229, 89, 146, 218, 430,
-	73, 160, 172, 173, 229, 176, 177, 178, 179, 361,
-	181, 183, 319, 185, 376, 154, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 207, 208, 317,
-	159, 169, 304, 305, 229, 217, 488, 158, 157, 303,
-	221, 229, 8, 135, 448, 297, 416, 226, 386, 479,
-	214, 385, 140, 140, 73, 140, 160, 90, 369, 239,
-	241, 160, 223, 140, 140, 248, 140, 286, 360, 214,
-	253, 320, 160, 213, 211, 359, 230, 231, 90, 233,
-	264, 93, 94, 104, 105, 261, 228, 242, 243, 417,
-	246, 229, 325, 268, 224, 53, 382, 214, 318, 160,
-	249, 132, 93, 94, 104, 105, 107, 108, 109, 74,
-	101, 102, 103, 106, 298, 160, 496, 88, 214, 147,
-	91, 170, 89, 151, 273, 236, 214, 277, 262, 280,
-	73, 101, 102, 103, 106, 214, 285, 214, 88, 270,
-	150, 91, 149, 89, 82, 140, 81, 138, 226, 265,
-	160, 301, 503, 502, 501, 287, 248, 499, 245, 309,
-	307, 6, 146, 271, 133, 314, 254, 72, 275, 294,
-	321, 495, 493, 131, 324, 487, 486, 315, 327, 484,
-	476, 335, 313, 475, 180, 470, 336, 337, 469, 346,
-	348, 142, 143, 468, 334, 466, 244, 338, 458, 136,
-	141, 331, 73, 457, 452, 445, 441, 439, 136, 73,
-	438, 371, 139, 90, 437, 434, 375, 367, 383, 377,
-	274, 140, 381, 429, 144, 281, 395, 379, 342, 343,
-	339, 227, 323, 272, 292, 255, 119, 93, 94, 492,
-	295, 392, 335, 238, 174, 378, 489, 336, 337, 455,
-	432, 393, 415, 250, 252, 334, 414, 358, 338, 234,
-	402, 222, 209, 78, 138, 407, 256, 257, 9, 405,
-	140, 404, 288, 88, 389, 388, 91, 418, 89, 140,
-	422, 73, 326, 212, 370, 425, 406, 428, 384, 296,
-	491, 431, 485, 394, 412, 254, 316, 396, 397, 83,
-	399, 156, 435, 419, 450, 175, 118, 357, 142, 143,
-	362, 413, 354, 353, 136, 355, 380, 141, 341, 306,
-	293, 282, 136, 225, 184, 130, 410, 73, 66, 139,
-	67, 68, 69, 51, 50, 459, 49, 433, 461, 73,
-	168, 144, 48, 47, 35, 312, 54, 34, 456, 350,
-	22, 440, 21, 442, 443, 20, 403, 27, 26, 446,
-	3, 269, 0, 140, 0, 0, 0, 453, 454, 73,
-	0, 0, 0, 420, 248, 483, 136, 0, 482, 0,
-	0, 136, 0, 0, 284, 465, 0, 474, 365, 291,
-	136, 0, 368, 0, 490, 0, 136, 471, 0, 0,
-	472, 473, 0, 0, 0, 0, 0, 0, 477, 291,
-	0, 40, 56, 57, 0, 0, 36, 0, 52, 0,
+const yyLast = 6266
+
+var yyAct = [...]int16{
+	93, 602, 2, 32, 626, 656, 83, 582, 457, 46,
+	443, 601, 298, 276, 453, 200, 96, 97, 10, 348,
+	100, 102, 31, 30, 349, 249, 29, 28, 421, 17,
+	275, 27, 26, 52, 146, 25, 4, 144, 147, 151,
+	84, 153, 24, 657, 350, 5, 444, 350, 8, 351,
+	350, 364, 176, 458, 454, 420, 149, 181, 5, 712,
+	700, 8, 696, 8, 8, 384, 8, 579, 192, 8,
+	526, 8, 633, 8, 8, 193, 194, 195, 196, 197,
+	363, 695, 260, 532, 8, 32, 175, 8, 417, 414,
+	629, 201, 8, 8, 8, 280, 176, 8, 190, 185,
+	8, 163, 470, 8, 209, 210, 468, 213, 214, 215,
+	216, 280, 218, 220, 286, 463, 640, 225, 382, 167,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
+	246, 247, 248, 189, 664, 706, 523, 8, 8, 259,
+	188, 401, 50, 574, 478, 265, 255, 634, 256, 485,
+	256, 187, 399, 707, 6, 277, 172, 173, 168, 301,
+	85, 190, 662, 445, 536, 628, 256, 171, 289, 291,
+	190, 266, 267, 190, 269, 299, 518, 397, 256, 492,
+	305, 167, 279, 280, 270, 729, 639, 377, 378, 169,
+	462, 256, 395, 170, 359, 174, 280, 603, 68, 69,
+	413, 727, 321, 491, 165, 280, 167, 287, 256, 376,
+	328, 273, 190, 165, 251, 490, 112, 324, 71, 72,
+	73, 329, 309, 256, 489, 308, 402, 190, 172, 173,
+	168, 311, 307, 461, 280, 294, 250, 400, 190, 171,
+	117, 118, 334, 7, 165, 641, 337, 604, 458, 341,
+	86, 344, 407, 172, 173, 168, 170, 392, 724, 356,
+	251, 169, 398, 190, 171, 723, 70, 174, 115, 366,
+	165, 720, 166, 110, 608, 552, 551, 396, 190, 358,
+	256, 374, 250, 606, 607, 703, 169, 299, 114, 111,
+	716, 386, 174, 709, 176, 705, 360, 389, 702, 381,
+	254, 688, 325, 190, 663, 653, 274, 165, 650, 184,
+	403, 631, 170, 170, 406, 170, 312, 190, 409, 630,
+	549, 618, 170, 170, 617, 423, 170, 616, 388, 86,
+	390, 613, 594, 437, 439, 221, 455, 165, 593, 588,
+	383, 587, 450, 424, 584, 165, 572, 428, 427, 568,
+	566, 426, 425, 201, 422, 442, 429, 448, 277, 164,
+	419, 565, 447, 564, 473, 170, 115, 559, 465, 477,
+	553, 110, 415, 416, 519, 471, 484, 505, 500, 499,
+	482, 487, 459, 433, 251, 430, 114, 111, 469, 211,
+	405, 281, 282, 391, 284, 336, 603, 68, 69, 199,
+	675, 292, 293, 498, 313, 297, 250, 183, 182, 86,
+	423, 165, 143, 680, 665, 591, 165, 71, 72, 73,
+	575, 354, 165, 480, 165, 224, 170, 535, 424, 274,
+	365, 512, 428, 427, 165, 516, 426, 425, 503, 422,
+	165, 429, 514, 534, 460, 285, 604, 152, 98, 583,
+	165, 515, 177, 365, 603, 68, 69, 277, 174, 283,
+	280, 9, 263, 361, 160, 70, 212, 545, 488, 548,
+	538, 495, 486, 608, 555, 71, 72, 73, 408, 393,
+	734, 733, 606, 607, 627, 365, 365, 713, 560, 170,
+	90, 701, 296, 563, 91, 254, 418, 554, 86, 142,
+	306, 531, 165, 557, 604, 369, 90, 667, 615, 600,
+	91, 539, 89, 577, 578, 449, 371, 223, 467, 268,
+	223, 537, 90, 70, 362, 170, 91, 530, 585, 90,
+	271, 608, 280, 207, 159, 595, 365, 1, 597, 467,
+	606, 607, 161, 599, 90, 180, 198, 611, 205, 179,
+	65, 178, 605, 104, 103, 586, 644, 657, 350, 444,
+	350, 679, 592, 651, 394, 92, 338, 351, 350, 467,
+	105, 345, 467, 165, 647, 732, 731, 355, 698, 357,
+	694, 186, 580, 562, 454, 464, 37, 346, 452, 367,
+	222, 254, 432, 254, 379, 370, 165, 170, 86, 162,
+	299, 649, 368, 352, 481, 380, 272, 158, 524, 86,
+	157, 529, 648, 170, 365, 38, 39, 156, 661, 642,
+	155, 88, 87, 660, 365, 525, 542, 79, 666, 80,
+	81, 669, 655, 82, 693, 676, 204, 673, 638, 550,
+	479, 63, 203, 681, 672, 605, 677, 674, 62, 61,
+	683, 60, 59, 66, 45, 687, 331, 446, 685, 690,
+	692, 217, 654, 347, 684, 441, 254, 23, 605, 34,
+	33, 456, 3, 466, 333, 0, 527, 68, 69, 0,
+	0, 581, 710, 253, 0, 0, 0, 467, 0, 0,
+	678, 0, 540, 718, 483, 0, 0, 71, 72, 73,
+	0, 605, 0, 0, 0, 726, 692, 0, 0, 165,
+	0, 86, 0, 605, 605, 0, 697, 728, 699, 0,
+	0, 0, 0, 170, 306, 736, 737, 501, 502, 0,
+	165, 0, 0, 605, 605, 711, 0, 0, 302, 304,
+	310, 0, 0, 0, 0, 70, 0, 170, 0, 0,
+	322, 513, 0, 608, 0, 165, 314, 315, 316, 317,
+	0, 165, 165, 0, 0, 0, 528, 0, 86, 0,
+	165, 0, 0, 86, 0, 330, 0, 0, 0, 0,
+	0, 541, 0, 0, 170, 0, 86, 0, 0, 0,
+	335, 0, 0, 0, 165, 339, 0, 165, 0, 0,
+	170, 0, 625, 365, 0, 0, 0, 0, 0, 165,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 165, 0, 0, 86, 643, 0, 0, 365,
+	0, 365, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 338, 0, 0, 0, 0, 0, 365, 0,
+	0, 467, 387, 165, 0, 467, 0, 0, 0, 0,
+	0, 0, 165, 670, 609, 170, 0, 467, 150, 68,
+	69, 0, 0, 47, 0, 64, 0, 0, 434, 686,
+	0, 0, 0, 0, 0, 622, 0, 165, 54, 71,
+	72, 73, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 451, 0, 0, 0, 0, 0, 0, 0,
+	637, 0, 0, 0, 0, 0, 645, 646, 0, 55,
+	74, 0, 472, 53, 0, 652, 57, 0, 0, 56,
+	0, 0, 0, 0, 0, 48, 0, 70, 51, 0,
+	0, 0, 494, 0, 730, 67, 0, 76, 78, 668,
+	0, 77, 671, 0, 49, 145, 0, 0, 0, 148,
+	0, 75, 0, 0, 682, 0, 112, 0, 0, 504,
+	0, 0, 0, 506, 507, 0, 509, 691, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 520, 112, 0,
+	117, 118, 128, 129, 0, 517, 0, 0, 533, 0,
+	0, 0, 0, 0, 0, 0, 714, 0, 715, 0,
+	717, 0, 117, 118, 128, 129, 0, 722, 115, 0,
+	0, 0, 725, 110, 0, 0, 558, 0, 0, 131,
+	132, 133, 0, 125, 126, 127, 130, 0, 114, 111,
+	115, 0, 735, 0, 0, 110, 567, 0, 569, 570,
+	0, 0, 0, 0, 0, 125, 126, 127, 130, 576,
+	114, 111, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 589, 590, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 612, 13, 68, 69, 0,
+	0, 47, 14, 64, 15, 16, 36, 0, 37, 619,
+	0, 620, 621, 0, 0, 0, 54, 71, 72, 73,
+	0, 35, 18, 0, 0, 0, 0, 0, 0, 632,
+	0, 11, 12, 0, 0, 0, 0, 38, 39, 0,
+	0, 19, 20, 0, 41, 42, 0, 55, 74, 0,
+	0, 53, 21, 22, 57, 40, 0, 56, 0, 43,
+	44, 658, 659, 48, 0, 70, 51, 0, 0, 0,
+	0, 0, 0, 67, 0, 76, 78, 0, 0, 77,
+	0, 0, 49, 58, 0, 0, 0, 0, 0, 75,
+	112, 134, 135, 139, 137, 141, 140, 0, 0, 0,
+	0, 109, 0, 689, 0, 0, 119, 120, 122, 123,
+	124, 121, 0, 0, 117, 118, 128, 129, 704, 0,
+	0, 0, 0, 0, 0, 0, 708, 113, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 719, 115, 0, 721, 0, 0, 110, 0, 0,
+	108, 136, 138, 131, 132, 133, 0, 125, 126, 127,
+	130, 0, 114, 111, 0, 0, 0, 0, 5, 0,
+	0, 8, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 544, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 543, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 497, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 496, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 476, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 475, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 412, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 411, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 373, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 372, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 327, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 326, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 635, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 614, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 596, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 0, 0,
+	0, 561, 112, 134, 135, 139, 137, 141, 140, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 119, 120,
+	122, 123, 124, 121, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 116, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 108, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111, 0, 0, 546, 547,
+	112, 134, 135, 139, 137, 141, 140, 0, 0, 0,
+	0, 109, 0, 0, 0, 0, 119, 120, 122, 123,
+	124, 121, 0, 0, 117, 118, 128, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 113, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 0, 0, 110, 0, 0,
+	108, 136, 138, 131, 132, 133, 0, 125, 126, 127,
+	130, 0, 114, 111, 0, 0, 0, 0, 440, 112,
+	134, 135, 139, 137, 141, 140, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 119, 120, 122, 123, 124,
+	121, 0, 0, 117, 118, 128, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 0, 0, 110, 0, 0, 108,
+	136, 138, 131, 132, 133, 0, 125, 126, 127, 130,
+	0, 114, 111, 0, 0, 0, 0, 342, 112, 134,
+	135, 139, 137, 141, 140, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 119, 120, 122, 123, 124, 121,
+	0, 0, 117, 118, 128, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 0, 0, 110, 0, 0, 108, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 0, 0, 318, 319, 112, 134, 135, 139,
+	137, 141, 140, 0, 0, 0, 0, 109, 0, 0,
+	0, 0, 119, 120, 122, 123, 124, 121, 0, 0,
+	117, 118, 128, 129, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 113, 0, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 115, 0,
+	0, 0, 0, 110, 0, 0, 108, 136, 138, 131,
+	132, 133, 0, 125, 126, 127, 130, 0, 114, 111,
+	0, 0, 636, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 0, 0, 598,
+	112, 134, 135, 139, 137, 141, 140, 0, 0, 0,
+	0, 109, 0, 0, 0, 0, 119, 120, 122, 123,
+	124, 121, 0, 0, 117, 118, 128, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 113, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 0, 0, 110, 0, 0,
+	108, 136, 138, 131, 132, 133, 0, 125, 126, 127,
+	130, 0, 114, 111, 0, 0, 493, 112, 134, 135,
+	139, 137, 141, 140, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 119, 120, 122, 123, 124, 121, 0,
+	0, 117, 118, 128, 129, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 115,
+	0, 0, 0, 0, 110, 0, 0, 108, 136, 138,
+	131, 132, 133, 0, 125, 126, 127, 130, 0, 114,
+	111, 0, 0, 375, 112, 134, 135, 139, 137, 141,
+	140, 0, 0, 0, 0, 109, 0, 0, 0, 0,
+	119, 120, 122, 123, 124, 121, 0, 0, 117, 118,
+	128, 129, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 0,
+	0, 110, 0, 0, 108, 136, 138, 131, 132, 133,
+	0, 125, 126, 127, 130, 0, 114, 111, 0, 0,
+	320, 112, 134, 135, 139, 137, 141, 140, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 119, 120, 122,
+	123, 124, 121, 0, 0, 117, 118, 128, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 115, 0, 0, 0, 0, 110, 0,
+	0, 108, 136, 138, 131, 132, 133, 0, 125, 126,
+	127, 130, 0, 114, 111, 0, 0, 288, 112, 134,
+	135, 139, 137, 141, 140, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 119, 120, 122, 123, 124, 121,
+	0, 0, 117, 118, 128, 129, 94, 68, 69, 0,
+	0, 47, 0, 64, 0, 113, 0, 116, 107, 0,
+	0, 0, 0, 0, 0, 0, 54, 71, 72, 73,
+	115, 35, 0, 0, 0, 110, 106, 0, 108, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 257, 0, 41, 42, 0, 55, 74, 0,
+	0, 53, 0, 0, 57, 40, 0, 56, 0, 43,
+	44, 0, 0, 48, 0, 70, 51, 0, 0, 0,
+	0, 0, 0, 67, 0, 76, 78, 0, 0, 77,
+	0, 0, 49, 58, 0, 0, 0, 0, 0, 75,
+	112, 134, 135, 139, 137, 141, 140, 0, 0, 0,
+	0, 109, 0, 0, 0, 0, 119, 120, 122, 123,
+	124, 121, 0, 0, 117, 118, 128, 129, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 113, 0, 116,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 115, 0, 0, 0, 0, 110, 0, 0,
+	108, 136, 138, 131, 132, 133, 0, 125, 126, 127,
+	130, 0, 114, 111, 571, 112, 134, 135, 139, 137,
+	141, 140, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 119, 120, 122, 123, 124, 121, 0, 0, 117,
+	118, 128, 129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 116, 522, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 115, 0, 0,
+	0, 0, 110, 0, 521, 108, 136, 138, 131, 132,
+	133, 0, 125, 126, 127, 130, 0, 114, 111, 112,
+	134, 135, 139, 137, 141, 140, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 119, 120, 122, 123, 124,
+	121, 0, 0, 117, 118, 128, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 0, 0, 110, 0, 0, 108,
+	136, 138, 131, 132, 133, 0, 125, 126, 127, 130,
+	0, 114, 111, 510, 112, 134, 135, 139, 137, 141,
+	140, 0, 0, 0, 0, 109, 0, 0, 0, 0,
+	119, 120, 122, 123, 124, 121, 0, 0, 117, 118,
+	128, 129, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 0, 116, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 115, 0, 0, 0,
+	0, 110, 0, 0, 108, 136, 138, 131, 132, 133,
+	0, 125, 126, 127, 130, 0, 114, 111, 508, 112,
+	134, 135, 139, 137, 141, 140, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 119, 120, 122, 123, 124,
+	121, 0, 0, 117, 118, 128, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 116, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 0, 0, 110, 106, 0, 108,
+	136, 138, 131, 132, 133, 0, 125, 126, 127, 130,
+	0, 114, 111, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 435, 112, 134,
+	135, 139, 137, 141, 140, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 119, 120, 122, 123, 124, 121,
+	0, 0, 117, 118, 128, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 0, 0, 110, 0, 0, 108, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 431, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 404, 112, 134,
+	135, 139, 137, 141, 140, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 119, 120, 122, 123, 124, 121,
+	0, 0, 117, 118, 128, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 0, 0, 110, 0, 0, 108, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 295, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 262, 111, 261, 112, 134,
+	135, 139, 137, 141, 140, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 119, 120, 122, 123, 124, 121,
+	0, 0, 117, 118, 128, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 0, 116, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 0, 0, 110, 0, 0, 108, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 252, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 624, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 112, 134, 135,
+	139, 137, 141, 140, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 119, 120, 122, 123, 124, 121, 0,
+	0, 117, 118, 128, 129, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 115,
+	0, 0, 0, 0, 110, 0, 623, 108, 136, 138,
+	131, 132, 133, 0, 125, 126, 127, 130, 0, 114,
+	111, 112, 134, 135, 139, 137, 141, 140, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 119, 120, 122,
+	123, 124, 121, 0, 0, 117, 118, 128, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 115, 0, 0, 0, 0, 110, 0,
+	610, 108, 136, 138, 131, 132, 133, 0, 125, 126,
+	127, 130, 0, 114, 111, 112, 134, 135, 139, 137,
+	141, 140, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 119, 120, 122, 123, 124, 121, 0, 0, 117,
+	118, 128, 129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 115, 0, 0,
+	0, 0, 110, 0, 573, 108, 136, 138, 131, 132,
+	133, 0, 125, 126, 127, 130, 0, 114, 111, 112,
+	134, 135, 139, 137, 141, 140, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 119, 120, 122, 123, 124,
+	121, 0, 0, 117, 118, 128, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 0, 0, 110, 0, 385, 108,
+	136, 138, 131, 132, 133, 0, 125, 126, 127, 130,
+	0, 114, 111, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	353, 0, 0, 0, 0, 115, 0, 0, 0, 0,
+	110, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 112, 134, 135,
+	139, 137, 141, 140, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 119, 120, 122, 123, 124, 121, 0,
+	0, 117, 118, 128, 129, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 113, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 115,
+	0, 0, 0, 0, 110, 0, 323, 108, 136, 138,
+	131, 132, 133, 0, 125, 126, 127, 130, 0, 114,
+	111, 112, 134, 135, 139, 137, 141, 140, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 119, 120, 122,
+	123, 124, 121, 0, 0, 117, 118, 128, 129, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 113, 0,
+	116, 0, 0, 0, 0, 0, 0, 0, 264, 0,
+	0, 0, 0, 115, 0, 0, 0, 0, 110, 0,
+	0, 108, 136, 138, 131, 132, 133, 0, 125, 126,
+	127, 130, 0, 114, 111, 112, 134, 135, 139, 137,
+	141, 140, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 119, 120, 122, 123, 124, 121, 0, 0, 117,
+	118, 128, 129, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 113, 0, 116, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 115, 0, 0,
+	0, 0, 110, 0, 0, 108, 136, 138, 131, 132,
+	133, 0, 125, 126, 127, 130, 0, 114, 111, 112,
+	134, 135, 139, 137, 141, 140, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 119, 120, 122, 123, 124,
+	121, 0, 0, 117, 118, 128, 129, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 113, 0, 116, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 115, 0, 0, 0, 0, 208, 0, 0, 108,
+	136, 138, 131, 132, 133, 0, 125, 126, 127, 130,
+	0, 114, 111, 112, 134, 135, 139, 137, 141, 140,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 119,
+	120, 122, 123, 124, 121, 0, 0, 117, 118, 128,
+	129, 94, 68, 69, 0, 300, 47, 0, 0, 0,
+	113, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 54, 71, 72, 73, 115, 0, 0, 0, 0,
+	206, 0, 0, 108, 136, 138, 131, 132, 133, 0,
+	125, 126, 127, 130, 0, 114, 111, 0, 0, 0,
+	0, 0, 55, 74, 0, 0, 53, 0, 0, 57,
+	0, 0, 56, 0, 0, 0, 0, 0, 48, 0,
+	70, 95, 0, 0, 0, 0, 0, 0, 67, 0,
+	76, 78, 0, 0, 77, 0, 0, 49, 58, 0,
+	0, 94, 68, 69, 75, 556, 47, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 54, 71, 72, 73, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 94, 68, 69, 0, 278, 47,
+	0, 0, 55, 74, 0, 0, 53, 0, 0, 57,
+	0, 0, 56, 0, 54, 71, 72, 73, 48, 0,
+	70, 95, 0, 0, 0, 0, 0, 0, 67, 0,
+	76, 78, 0, 0, 77, 0, 0, 49, 58, 0,
+	0, 0, 0, 0, 75, 55, 74, 0, 0, 53,
+	0, 0, 57, 0, 0, 56, 0, 0, 0, 0,
+	0, 48, 0, 70, 95, 0, 0, 0, 0, 0,
+	0, 67, 0, 76, 78, 0, 0, 77, 0, 0,
+	49, 58, 0, 94, 68, 69, 0, 75, 47, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 54, 71, 72, 73, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 94, 68, 69, 0,
+	0, 47, 0, 0, 55, 74, 0, 0, 53, 0,
+	0, 57, 0, 0, 56, 0, 54, 71, 72, 73,
+	48, 0, 70, 95, 0, 0, 0, 0, 0, 0,
+	67, 0, 76, 78, 0, 0, 77, 0, 0, 49,
+	58, 0, 0, 0, 0, 474, 75, 55, 74, 0,
+	0, 53, 0, 0, 57, 0, 0, 56, 0, 0,
+	0, 0, 0, 48, 202, 70, 95, 0, 0, 0,
+	0, 0, 0, 67, 0, 76, 78, 0, 0, 77,
+	0, 0, 49, 58, 0, 94, 68, 69, 0, 75,
+	47, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 54, 71, 72, 73, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 94, 68,
+	69, 0, 0, 47, 0, 0, 55, 74, 0, 0,
+	53, 0, 0, 57, 0, 0, 56, 0, 54, 71,
+	72, 73, 48, 0, 70, 95, 0, 0, 0, 0,
+	0, 0, 67, 0, 76, 78, 0, 0, 77, 0,
+	0, 49, 58, 0, 0, 0, 0, 410, 75, 55,
+	74, 0, 0, 53, 0, 0, 57, 0, 0, 56,
+	0, 0, 0, 0, 0, 48, 0, 70, 95, 0,
+	0, 0, 0, 0, 0, 67, 0, 76, 78, 0,
+	0, 77, 0, 0, 49, 58, 0, 0, 0, 343,
+	0, 75, 94, 68, 69, 0, 0, 47, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 54, 71, 72, 73, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	43, 58, 59, 60, 0, 28, 463, 0, 212, 0,
-	0, 0, 494, 0, 0, 497, 498, 0, 0, 500,
-	408, 363, 0, 0, 0, 0, 33, 44, 61, 0,
-	0, 42, 0, 0, 45, 32, 0, 0, 0, 291,
-	0, 0, 363, 55, 0, 63, 65, 0, 0, 64,
-	0, 46, 0, 39, 0, 0, 0, 0, 37, 0,
-	62, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 212, 0, 212,
-	0, 0, 136, 0, 40, 56, 57, 0, 409, 36,
-	13, 52, 14, 29, 0, 30, 0, 291, 0, 421,
-	0, 0, 0, 43, 58, 59, 60, 0, 28, 16,
-	0, 0, 0, 0, 0, 0, 0, 0, 11, 12,
-	0, 0, 0, 0, 31, 0, 0, 17, 0, 33,
-	44, 61, 0, 212, 42, 18, 19, 45, 32, 0,
-	0, 0, 0, 451, 0, 0, 55, 0, 63, 65,
-	0, 0, 64, 0, 46, 0, 39, 0, 0, 0,
-	0, 37, 136, 62, 0, 0, 0, 0, 0, 0,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 291, 424, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 0, 0,
-	88, 0, 0, 91, 0, 89, 423, 90, 110, 111,
-	115, 113, 117, 116, 0, 0, 0, 0, 87, 0,
-	0, 0, 0, 95, 96, 98, 99, 100, 97, 0,
-	0, 93, 94, 104, 105, 0, 0, 0, 0, 0,
-	0, 0, 92, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 86, 112, 114, 107, 108, 109, 0,
-	101, 102, 103, 106, 0, 0, 0, 88, 0, 0,
-	91, 0, 89, 390, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 0, 0, 88, 0, 0, 91, 0, 89,
-	373, 90, 110, 111, 115, 113, 117, 116, 0, 0,
-	0, 0, 87, 0, 0, 0, 0, 95, 96, 98,
-	99, 100, 97, 0, 0, 93, 94, 104, 105, 0,
-	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 330, 86, 112, 114,
-	107, 108, 109, 0, 101, 102, 103, 106, 0, 0,
-	0, 88, 0, 0, 91, 0, 89, 329, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 300, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 0,
-	0, 91, 0, 89, 299, 90, 110, 111, 115, 113,
-	117, 116, 0, 0, 0, 0, 87, 0, 0, 0,
-	0, 95, 96, 98, 99, 100, 97, 0, 0, 93,
-	94, 104, 105, 0, 0, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	267, 86, 112, 114, 107, 108, 109, 0, 101, 102,
-	103, 106, 0, 0, 0, 88, 0, 0, 91, 0,
-	89, 266, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 85, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	215, 0, 88, 0, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 0,
-	0, 91, 0, 89, 480, 90, 110, 111, 115, 113,
-	117, 116, 0, 0, 0, 0, 87, 0, 0, 0,
-	0, 95, 96, 98, 99, 100, 97, 0, 0, 93,
-	94, 104, 105, 0, 0, 0, 0, 0, 0, 0,
-	92, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 86, 112, 114, 107, 108, 109, 0, 101, 102,
-	103, 106, 0, 0, 0, 88, 0, 0, 91, 0,
-	89, 467, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 0, 0, 91, 0, 89, 460, 90,
-	110, 111, 115, 113, 117, 116, 0, 0, 0, 0,
-	87, 0, 0, 0, 0, 95, 96, 98, 99, 100,
-	97, 0, 0, 93, 94, 104, 105, 0, 0, 0,
-	0, 0, 0, 0, 92, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 86, 112, 114, 107, 108,
-	109, 0, 101, 102, 103, 106, 0, 0, 0, 88,
-	0, 0, 91, 0, 89, 436, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 426, 427, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 85, 0,
-	0, 0, 0, 0, 0, 0, 84, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 0, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 258,
-	259, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 94, 68, 69, 0, 0,
+	47, 0, 0, 55, 74, 0, 0, 53, 0, 0,
+	57, 0, 0, 56, 0, 54, 71, 72, 73, 48,
+	0, 70, 95, 0, 290, 0, 0, 0, 0, 67,
+	0, 76, 78, 0, 0, 77, 0, 0, 49, 58,
+	0, 0, 0, 0, 0, 75, 55, 74, 0, 0,
+	53, 0, 0, 57, 0, 0, 56, 0, 0, 0,
+	0, 0, 48, 0, 70, 95, 0, 0, 0, 0,
+	0, 0, 67, 0, 76, 78, 0, 0, 77, 0,
+	0, 49, 58, 0, 0, 0, 258, 0, 75, 94,
+	68, 69, 0, 0, 47, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 54,
+	71, 72, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 0, 0, 88, 481, 0, 91, 0, 89,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 464, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 0, 0,
-	88, 0, 0, 91, 0, 89, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 462, 0, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 447, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 0, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 444, 0, 88, 0,
-	0, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 94, 68, 69, 0, 0, 47, 0, 0,
+	55, 74, 0, 0, 53, 0, 0, 57, 0, 0,
+	56, 0, 54, 71, 72, 73, 48, 0, 70, 95,
+	0, 219, 0, 0, 0, 0, 67, 0, 76, 78,
+	0, 0, 77, 0, 0, 49, 58, 0, 0, 0,
+	0, 0, 75, 55, 74, 0, 0, 53, 0, 0,
+	57, 0, 0, 56, 0, 0, 0, 0, 0, 48,
+	0, 70, 95, 0, 0, 0, 0, 0, 0, 67,
+	0, 76, 78, 0, 0, 77, 0, 154, 49, 58,
+	0, 94, 68, 69, 0, 75, 47, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 400, 0, 88, 0, 0, 91, 0, 89,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 398, 0,
-	88, 0, 0, 91, 0, 89, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 387, 0, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 0, 0, 91, 349, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 344, 0, 88, 0,
-	0, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 54, 71, 72, 73, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 340, 0, 88, 0, 0, 91, 0, 89,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 322, 0,
-	88, 0, 0, 91, 0, 89, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 311, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 0, 0, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 302, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 0,
-	0, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 94, 68, 69, 0, 0, 47,
+	0, 0, 55, 74, 0, 0, 53, 0, 0, 57,
+	0, 0, 56, 0, 54, 71, 72, 73, 48, 0,
+	70, 95, 0, 0, 0, 0, 0, 0, 67, 0,
+	76, 78, 0, 0, 77, 0, 0, 49, 58, 0,
+	0, 0, 0, 0, 75, 55, 74, 0, 0, 53,
+	0, 0, 57, 0, 0, 56, 0, 0, 0, 0,
+	0, 48, 0, 70, 95, 0, 0, 0, 0, 0,
+	0, 67, 0, 76, 78, 0, 0, 77, 0, 0,
+	49, 511, 0, 94, 68, 69, 0, 75, 47, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 0, 0, 88, 0, 0, 91, 278, 89,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 263, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 0, 0,
-	88, 0, 0, 91, 0, 89, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 260, 0, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 88, 237, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
-	0, 0, 0, 0, 95, 96, 98, 99, 100, 97,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 0,
-	0, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	95, 96, 98, 99, 100, 97, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 54, 71, 72, 73, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 219, 0, 88, 0, 0, 91, 0, 89,
-	90, 110, 111, 115, 113, 117, 116, 0, 0, 0,
-	0, 87, 0, 0, 0, 0, 95, 96, 98, 99,
-	100, 97, 0, 0, 93, 94, 104, 105, 0, 0,
-	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 86, 112, 114, 107,
-	108, 109, 0, 101, 102, 103, 106, 0, 210, 0,
-	88, 0, 0, 91, 0, 89, 90, 110, 111, 115,
-	113, 117, 116, 0, 0, 0, 0, 87, 0, 0,
-	0, 0, 95, 96, 98, 99, 100, 97, 0, 0,
-	93, 94, 104, 105, 0, 0, 0, 0, 0, 0,
-	0, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 86, 112, 114, 107, 108, 109, 0, 101,
-	102, 103, 106, 0, 0, 0, 88, 0, 0, 91,
-	0, 89, 90, 110, 111, 115, 113, 117, 116, 0,
-	0, 0, 0, 87, 0, 0, 0, 0, 95, 96,
-	98, 99, 100, 97, 0, 0, 93, 94, 104, 105,
-	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 86, 112,
-	114, 107, 108, 109, 0, 101, 102, 103, 106, 0,
-	0, 0, 171, 0, 0, 91, 0, 89, 90, 110,
-	111, 115, 113, 117, 116, 0, 0, 0, 0, 87,
+	0, 0, 0, 0, 0, 0, 94, 68, 69, 0,
+	0, 47, 0, 0, 55, 74, 0, 0, 53, 0,
+	0, 57, 0, 0, 56, 0, 54, 71, 72, 73,
+	48, 0, 70, 95, 0, 0, 0, 0, 0, 0,
+	67, 0, 76, 78, 0, 0, 77, 0, 0, 49,
+	438, 0, 0, 0, 0, 0, 75, 55, 74, 0,
+	0, 53, 0, 0, 57, 0, 0, 56, 0, 0,
+	0, 0, 0, 48, 0, 70, 95, 0, 0, 0,
+	0, 0, 0, 67, 0, 76, 78, 0, 0, 77,
+	0, 0, 49, 436, 0, 150, 68, 69, 0, 75,
+	47, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 54, 71, 72, 73, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 93, 94, 104, 105, 0, 0, 0, 0,
-	0, 0, 0, 92, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 86, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 0, 0, 0, 88, 0,
-	0, 91, 0, 89, 90, 110, 111, 115, 113, 117,
-	116, 0, 0, 0, 0, 87, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 94, 68,
+	69, 0, 0, 47, 0, 0, 55, 74, 0, 0,
+	53, 0, 0, 57, 0, 0, 56, 0, 54, 71,
+	72, 73, 48, 0, 70, 95, 0, 0, 0, 0,
+	0, 0, 67, 0, 76, 78, 0, 0, 77, 0,
+	0, 49, 58, 0, 0, 0, 0, 0, 75, 55,
+	74, 0, 0, 53, 0, 0, 57, 0, 0, 56,
+	0, 0, 0, 0, 0, 48, 0, 70, 95, 0,
+	0, 0, 0, 0, 0, 67, 0, 76, 78, 0,
+	0, 77, 0, 0, 49, 340, 0, 332, 68, 69,
+	0, 75, 47, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 54, 71, 72,
+	73, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	86, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 126, 56, 57, 88, 0, 36, 91, 52, 89,
+	94, 68, 69, 0, 0, 47, 0, 0, 55, 74,
+	0, 0, 53, 0, 0, 57, 0, 0, 56, 0,
+	54, 71, 72, 73, 48, 0, 70, 95, 0, 0,
+	0, 0, 0, 0, 67, 0, 76, 78, 0, 0,
+	77, 0, 0, 49, 58, 0, 0, 0, 0, 0,
+	75, 55, 74, 0, 0, 53, 0, 0, 57, 0,
+	0, 56, 0, 0, 0, 0, 0, 48, 0, 70,
+	303, 0, 0, 0, 0, 0, 0, 67, 0, 76,
+	78, 0, 0, 77, 0, 0, 49, 58, 0, 94,
+	191, 69, 0, 75, 47, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 54,
+	71, 72, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	43, 58, 59, 60, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 40, 56, 57, 0,
-	0, 36, 0, 0, 0, 0, 0, 44, 61, 0,
-	0, 42, 0, 0, 45, 43, 58, 59, 60, 0,
-	0, 0, 0, 55, 0, 63, 65, 0, 0, 64,
-	0, 121, 0, 39, 40, 56, 57, 124, 37, 36,
-	62, 0, 44, 61, 0, 0, 42, 0, 0, 45,
-	0, 0, 0, 43, 58, 59, 60, 0, 55, 0,
-	63, 65, 0, 0, 64, 0, 46, 0, 76, 0,
-	0, 0, 0, 37, 372, 62, 0, 0, 0, 0,
-	44, 61, 0, 0, 42, 0, 0, 45, 40, 56,
-	57, 0, 0, 36, 0, 0, 55, 0, 63, 65,
-	0, 0, 64, 0, 46, 0, 76, 43, 58, 59,
-	60, 37, 328, 62, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 40, 56, 57, 0, 0, 36, 0,
-	0, 0, 0, 0, 44, 61, 0, 0, 42, 0,
-	0, 45, 43, 58, 59, 60, 0, 0, 0, 0,
-	55, 0, 63, 65, 0, 0, 64, 0, 46, 0,
-	76, 0, 0, 0, 279, 37, 0, 62, 0, 44,
-	61, 0, 0, 42, 0, 0, 45, 40, 56, 57,
-	240, 0, 36, 0, 0, 55, 0, 63, 65, 0,
-	0, 64, 0, 46, 0, 76, 43, 58, 59, 60,
-	37, 0, 62, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 40, 56, 57, 0, 0, 36, 0, 0,
-	0, 0, 0, 44, 61, 0, 0, 42, 0, 0,
-	45, 43, 58, 59, 60, 0, 0, 0, 0, 55,
-	0, 63, 65, 0, 0, 64, 0, 46, 0, 76,
-	0, 0, 0, 216, 37, 0, 62, 0, 44, 61,
-	0, 0, 42, 0, 0, 45, 40, 56, 57, 182,
-	0, 36, 0, 0, 55, 0, 63, 65, 0, 0,
-	64, 0, 46, 0, 76, 43, 58, 59, 60, 37,
-	0, 62, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 40, 56, 57, 0, 0, 36, 0, 0, 0,
-	0, 0, 44, 61, 0, 0, 42, 0, 0, 45,
-	43, 58, 59, 60, 0, 0, 0, 0, 55, 0,
-	63, 65, 0, 0, 64, 0, 46, 0, 76, 0,
-	0, 129, 0, 37, 0, 62, 0, 44, 61, 0,
-	0, 42, 0, 0, 45, 40, 56, 57, 0, 0,
-	36, 0, 0, 55, 0, 63, 65, 0, 0, 64,
-	0, 46, 0, 76, 43, 58, 59, 60, 37, 0,
-	62, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	40, 56, 57, 0, 0, 36, 0, 0, 0, 0,
-	0, 44, 61, 0, 0, 42, 0, 0, 45, 43,
-	58, 59, 60, 0, 0, 0, 0, 55, 0, 63,
-	65, 0, 0, 64, 0, 401, 0, 76, 40, 56,
-	57, 0, 37, 36, 62, 0, 44, 61, 0, 0,
-	42, 0, 0, 45, 0, 0, 0, 43, 58, 59,
-	60, 0, 55, 0, 63, 65, 0, 0, 64, 0,
-	347, 0, 76, 126, 56, 57, 0, 37, 36, 62,
-	0, 0, 0, 0, 44, 61, 0, 0, 42, 0,
-	0, 45, 43, 58, 59, 60, 0, 0, 0, 0,
-	55, 0, 63, 65, 0, 0, 64, 0, 345, 0,
-	76, 40, 56, 57, 0, 37, 36, 62, 0, 44,
-	61, 0, 0, 42, 0, 0, 45, 0, 0, 0,
-	43, 58, 59, 60, 0, 55, 0, 63, 65, 0,
-	0, 64, 0, 46, 0, 76, 0, 0, 0, 0,
-	37, 0, 62, 0, 0, 0, 0, 44, 61, 0,
-	0, 42, 0, 0, 45, 0, 0, 0, 90, 110,
-	111, 115, 113, 55, 116, 63, 65, 0, 0, 64,
-	0, 276, 0, 76, 0, 0, 0, 0, 37, 0,
-	62, 0, 93, 94, 104, 105, 0, 0, 0, 0,
+	0, 0, 101, 68, 69, 0, 0, 47, 0, 0,
+	55, 74, 0, 0, 53, 0, 0, 57, 0, 0,
+	56, 0, 54, 71, 72, 73, 48, 0, 70, 95,
+	0, 0, 0, 0, 0, 0, 67, 0, 76, 78,
+	0, 0, 77, 0, 0, 49, 58, 0, 0, 0,
+	0, 0, 75, 55, 74, 0, 0, 53, 0, 0,
+	57, 0, 0, 56, 0, 0, 0, 0, 0, 48,
+	0, 70, 95, 0, 0, 0, 0, 0, 0, 67,
+	0, 76, 78, 0, 0, 77, 0, 0, 49, 58,
+	0, 99, 68, 69, 0, 75, 47, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 112, 114, 107, 108, 109,
-	0, 101, 102, 103, 106, 40, 56, 57, 88, 0,
-	36, 91, 0, 89, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 43, 58, 59, 60, 0, 0,
+	0, 54, 71, 72, 73, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	40, 161, 57, 0, 0, 36, 0, 0, 0, 0,
-	0, 44, 61, 0, 0, 42, 0, 0, 45, 43,
-	58, 59, 60, 0, 0, 0, 0, 55, 0, 63,
-	65, 0, 0, 64, 0, 46, 0, 251, 79, 56,
-	57, 0, 37, 36, 62, 0, 44, 61, 0, 0,
-	42, 0, 0, 45, 0, 0, 0, 43, 58, 59,
-	60, 0, 55, 0, 63, 65, 0, 0, 64, 0,
-	46, 0, 76, 0, 0, 0, 0, 37, 0, 62,
-	0, 0, 0, 0, 44, 61, 0, 0, 42, 0,
-	0, 45, 0, 0, 90, 110, 111, 115, 113, 0,
-	55, 0, 63, 65, 0, 0, 64, 0, 46, 0,
-	76, 0, 0, 0, 0, 37, 0, 62, 93, 94,
-	104, 105, 0, 0, 0, 0, 0, 0, 0, 0,
+	112, 134, 135, 139, 137, 141, 140, 0, 0, 0,
+	0, 109, 55, 74, 0, 0, 53, 0, 0, 57,
+	0, 0, 56, 0, 117, 118, 128, 129, 48, 0,
+	70, 95, 0, 0, 0, 0, 0, 113, 67, 116,
+	76, 78, 0, 0, 77, 0, 0, 49, 58, 0,
+	0, 0, 115, 0, 75, 0, 0, 110, 0, 0,
+	108, 136, 138, 131, 132, 133, 0, 125, 126, 127,
+	130, 0, 114, 111, 112, 134, 135, 139, 137, 141,
+	140, 0, 0, 0, 0, 109, 0, 0, 112, 134,
+	135, 139, 137, 0, 140, 0, 0, 0, 117, 118,
+	128, 129, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 113, 117, 118, 128, 129, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 113, 115, 0, 0, 0,
+	0, 110, 0, 0, 108, 136, 138, 131, 132, 133,
+	115, 125, 126, 127, 130, 110, 114, 111, 0, 136,
+	138, 131, 132, 133, 0, 125, 126, 127, 130, 0,
+	114, 111, 112, 134, 135, 139, 137, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 112, 114, 107, 108, 109, 0, 101, 102, 103,
-	106, 0, 0, 0, 88, 0, 0, 91, 0, 89,
+	0, 0, 0, 0, 0, 0, 117, 118, 128, 129,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 113,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 115, 0, 0, 0, 0, 110,
+	0, 0, 0, 136, 138, 131, 132, 133, 0, 125,
+	126, 127, 130, 0, 114, 111,
 }
-var yyPact = [...]int{
-
-	-36, -1000, 600, -36, -1000, -67, -67, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3667, 3667, -1000, 279, 4054, 160, 158,
-	375, -1000, -1000, -1000, -1000, 1476, -1000, -1000, 322, 3667,
-	3327, 3667, 3632, 411, -1000, -1000, 187, -60, 350, 3829,
-	133, -23, 156, 154, 137, -13, -67, -1000, -1000, -1000,
-	-1000, -1000, 387, 68, -1000, 4016, -1000, -1000, -1000, -1000,
-	-1000, 3667, 3667, 3667, 3667, 3667, -1000, -1000, -1000, -1000,
-	-1000, 600, -67, -1000, 3, 3060, 3667, 3060, -36, 135,
-	3126, 3667, 3667, 321, 3667, 3667, 3667, 3667, 3667, 3578,
-	3667, 410, 3667, -1000, -1000, 3667, 3667, 3667, 3667, 3667,
-	3667, 3667, 3667, 3667, 3667, 3667, 3667, 3667, 3667, 3667,
-	3667, 3667, 3667, 3667, 3667, 3667, 3667, 3667, -1000, 278,
-	2994, -36, 147, 1076, 3543, -2, 133, 2928, 2862, 3667,
-	277, 387, 108, 409, -22, 3667, -67, 102, -1000, 350,
-	350, -12, 350, 275, -32, 138, 2796, 3667, 3489, 3667,
-	350, 233, -67, 350, 3667, 130, -1000, 3667, 3981, 3667,
-	-67, -1000, -5, 3192, -5, -5, -5, -5, -1000, 250,
-	3667, 3667, 1542, 2730, 3667, -36, 3060, 3060, 2664, 3258,
-	162, 1009, 3667, 287, -1000, 3192, 3060, 3060, 3060, 3060,
-	3060, 3060, 287, 287, 287, 287, 287, 287, 152, 152,
-	152, 131, 131, 131, 131, 131, 131, 4098, 3912, -36,
-	-36, 248, -67, 3667, -67, -36, 3867, 2598, 3454, -67,
-	407, 2532, -67, 149, 387, 295, -1000, -29, -67, 406,
-	-4, -4, 350, -4, -67, -22, 319, -1000, 127, 942,
-	3667, 2466, 52, 45, 405, 3667, -25, -33, 2400, 3667,
-	3, 3829, 3, 3060, 3667, 356, 111, 84, -1000, 3667,
-	-1000, 2334, 247, 3667, 105, 310, -1000, 3400, 875, -41,
-	497, 245, -1000, 2268, 404, 243, -36, 2202, 3794, 3756,
-	2136, 357, -1000, 401, 48, 273, 88, 81, 396, -67,
-	-55, -67, 3667, -1000, -44, 393, 3667, 71, 312, -1000,
-	3362, 808, -1000, -1000, -1000, 3667, 16, -33, 350, 242,
-	-67, 3667, 3, 109, 3060, -23, 304, 64, 312, 61,
-	310, 2070, -36, -1000, 3192, 302, -1000, 741, -1000, -1000,
-	3667, 497, -1000, -1000, -1000, 1476, -1000, -1000, -1000, -1000,
-	-36, -1000, -1000, 241, -36, -36, 2004, -36, 1938, 3721,
-	-17, -1000, -1000, 315, 3667, -1000, -31, 350, -36, 272,
-	268, 59, 103, -67, -1000, -29, 350, -31, 3, 308,
-	-1000, 674, -1000, -1000, 3667, 1410, 3667, 238, 5, -1000,
-	3667, 3060, -1000, 266, -36, 308, 302, -1000, 230, -1000,
-	-1000, 3667, 1343, -1000, 229, -1000, 225, 222, -36, 221,
-	-36, -36, 1872, 220, -1000, -1000, -36, 1806, 73, -38,
-	390, -67, -4, 219, -36, -36, 265, 387, 218, -4,
-	213, -67, -1000, -1000, 3667, 1276, -1000, 3667, 1740, -1000,
-	-67, 1674, -36, 210, -1000, 1209, -1000, -1000, -1000, -1000,
-	208, -1000, 203, 200, -36, -1000, -1000, -36, -36, -1000,
-	350, -67, -1000, 198, 195, -36, 62, -1000, -1000, 1142,
-	-1000, 1608, -1000, 3667, 3667, 194, 351, -1000, -1000, -1000,
-	-1000, 191, -1000, -1000, -4, -1000, -1000, 190, 49, 262,
-	-1000, -1000, -33, 3060, 349, 255, -1000, -1000, 188, -36,
-	186, 132, -36, -36, 172, -1000, -36, 169, 168, -1000,
-	167, -1000, -1000, -1000,
+
+var yyPact = [...]int16{
+	-50, -1000, 1102, -50, -1000, -27, -27, -1000, -1000, -1000,
+	-1000, 628, 627, 446, 5327, 5327, 5327, -1000, 367, 5987,
+	5898, 490, 489, 565, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 3262, -1000, -1000, 418, 5327, 874, 5327, 366,
+	5238, 626, 623, 616, 613, -1000, -1000, 470, 5327, 5,
+	212, 5591, 372, 487, 485, 481, 5327, 327, -27, -1000,
+	-1000, -1000, -1000, -1000, 587, 86, -1000, 5855, -1000, -1000,
+	-1000, -1000, -1000, -1000, 5327, 5327, 5327, 5327, 5327, -1000,
+	-1000, -1000, -1000, -1000, 1102, -27, -1000, -1000, -1000, -27,
+	4832, 5327, 4, 4378, 462, 5327, 4378, 4378, -50, 484,
+	4526, 469, 4452, 5327, 5327, 385, 5327, 5327, 5327, 5327,
+	5327, 5195, 5327, 212, 526, -27, 5327, -1000, -1000, 5327,
+	5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327,
+	5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327, 5327,
+	5327, 5327, -1000, 325, 3711, -50, 139, 2841, 5101, -13,
+	462, 3636, -27, 4304, 5327, 325, 325, 454, 325, 587,
+	466, 612, 128, 378, 4700, -27, 155, -1000, -1000, 212,
+	212, 379, 212, 364, 18, 124, 2764, 5058, 5327, 212,
+	187, 3561, -27, 212, 4567, 94, -1000, 5327, 5766, 5327,
+	-27, -1000, 307, 6013, 307, 307, 307, 307, -1000, 580,
+	-1000, 4378, -50, 233, 322, 5327, 5327, 5327, 5327, 2301,
+	2687, 5327, -50, 4378, 4378, 4230, 6087, 219, 1665, 5327,
+	209, 381, 212, -1000, 5723, 6013, 4378, 4378, 4378, 4378,
+	4378, 4378, 209, 209, 209, 209, 209, 209, 981, 981,
+	981, 959, 959, 959, 959, 959, 959, 6175, 6101, -50,
+	-1000, -1000, -50, 313, -27, 5327, -27, -50, 5634, 2222,
+	4964, -27, 523, 530, 609, 4156, -27, -27, 5327, -27,
+	196, 587, 391, 464, -1000, -14, -1000, 4378, 5327, -27,
+	608, 381, 381, 212, 381, -27, 378, 451, -1000, 1585,
+	5327, 2610, 126, 104, 600, -27, 4567, 22, -29, 4082,
+	5327, 5327, 4, 5591, 4, 4378, 5327, -1000, -1000, -1000,
+	311, 174, 412, 543, 194, 179, 154, 143, -1000, 5327,
+	-1000, 3486, 308, 5327, 169, 411, -1000, 4921, 1505, 117,
+	-5, -6, 430, -37, 2882, 303, -1000, 3411, 598, 301,
+	-50, 3336, 5502, 5459, 2143, 522, 115, 2, -1000, -1000,
+	449, 5327, -1000, 594, 50, 254, 4378, 587, 363, 150,
+	107, 591, 4832, -27, 10, -27, 4378, 4700, -1000, 6,
+	590, 5327, -1000, 4789, 1425, -1000, -1000, -1000, 5327, 60,
+	-1000, -29, 212, 298, -27, 5327, 4378, 4, 66, 4378,
+	372, -1000, 405, -1000, 387, 141, 412, 132, 411, 120,
+	412, 96, 411, 2533, -50, -1000, 6013, 404, -1000, 1345,
+	-1000, -1000, 5327, -1000, -27, 297, 296, -27, -27, 2882,
+	-1000, -1000, -1000, 3262, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -50, -1000, -1000, 295, -50, -50, 3187, -50, 3112,
+	5370, -1, -1000, -1000, 5327, 93, 292, -1000, -1000, -50,
+	3038, 89, -1000, -24, 212, -1000, -27, -1000, 463, -11,
+	-50, 362, 346, 81, 457, -1000, 4700, -27, -1000, -14,
+	212, -24, 4, 1265, -1000, -1000, 5327, 2065, 5327, 238,
+	288, 155, -1000, 4657, 4378, -1000, -1000, 325, -50, 405,
+	404, 405, 404, -1000, 285, -1000, -1000, 5327, 1985, -1000,
+	-1000, 589, 5327, -1000, 281, -1000, 279, 268, -50, 267,
+	-50, -50, 2963, 264, -1000, -1000, 4008, 77, 339, -1000,
+	-1000, -50, 5327, 5327, -25, 588, -27, 453, 262, 49,
+	587, 259, -27, 257, -50, -50, 334, 587, -1000, 256,
+	381, 250, -27, -1000, 5327, 1905, -1000, 5327, 2456, -1000,
+	-50, 443, 460, -1000, -27, 3934, 5327, -50, 249, -1000,
+	1825, -1000, 442, 4378, -1000, -1000, -1000, 245, -1000, 242,
+	239, -50, -1000, -50, -50, -27, -1000, 3860, 3786, -1000,
+	212, -27, 419, -1000, -1000, -1000, 82, -1000, -1000, 237,
+	229, -50, 64, -1000, -1000, 1745, -1000, 2379, -1000, -1000,
+	-27, 102, -1000, 201, 212, 500, -27, -27, 579, 4567,
+	5327, 4378, 226, 541, -1000, -27, -1000, -1000, -1000, 223,
+	-1000, -1000, 520, -50, -50, 453, -1000, 5327, -1000, 79,
+	-1000, -1000, 222, 51, 333, -1000, -1000, 5327, 441, -27,
+	5327, 212, -27, 381, 682, 402, 682, -1000, -29, 4378,
+	539, 332, 5327, -1000, -4, -1000, -1000, 212, -1000, -1000,
+	419, 4378, -1000, -1000, 220, -50, 1183, -27, 460, 4378,
+	381, 586, -1000, -15, -32, 584, -34, 425, 216, 204,
+	-50, 4378, 213, -1000, -1000, 69, 381, -1000, -50, 211,
+	-1000, 5327, -1000, -35, 421, -1000, -27, -1000, -27, 208,
+	-27, 460, -1000, -50, 189, -1000, -50, -27, 183, -1000,
+	4378, 176, -27, 460, 203, -1000, -1000, 682, -1000, 103,
+	-1000, -1000, 212, -1000, -1000, 582, -1000, 581, 415, -1000,
+	381, 414, -27, 460, 460, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 42, 451, 9, 450, 358, 5, 16, 13, 6,
-	448, 447, 445, 442, 440, 439, 10, 8, 185, 0,
-	20, 26, 11, 2, 437, 436, 12, 434, 4, 433,
-	432, 426, 424, 423, 422, 421, 420, 418, 19, 21,
-	251, 95, 1, 416, 7,
+var yyPgo = [...]int16{
+	0, 547, 684, 28, 682, 471, 18, 31, 29, 27,
+	26, 23, 22, 681, 8, 680, 679, 677, 42, 35,
+	675, 10, 19, 32, 673, 24, 672, 5, 560, 30,
+	13, 0, 34, 231, 14, 668, 152, 7, 666, 4,
+	15, 664, 663, 33, 9, 12, 662, 661, 659, 658,
+	651, 650, 649, 648, 11, 1, 647, 645, 644, 643,
+	640, 639, 637, 2, 36, 319, 25, 164, 51, 635,
+	253,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]int8{
 	0, 1, 1, 4, 4, 2, 2, 3, 3, 3,
-	3, 3, 3, 5, 5, 5, 5, 5, 5, 5,
+	3, 3, 3, 3, 3, 3, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 5, 5, 8, 8, 6,
-	6, 7, 7, 7, 7, 10, 11, 11, 11, 11,
-	11, 11, 11, 12, 12, 12, 13, 13, 13, 13,
-	13, 13, 13, 13, 13, 13, 13, 9, 14, 15,
-	15, 15, 15, 15, 16, 16, 17, 18, 18, 18,
-	18, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	19, 19, 19, 19, 19, 19, 19, 19, 19, 19,
-	20, 20, 20, 21, 21, 21, 21, 21, 21, 21,
-	22, 22, 23, 23, 24, 24, 25, 26, 27, 27,
-	27, 27, 27, 27, 28, 28, 28, 29, 29, 29,
-	29, 29, 29, 29, 29, 29, 29, 30, 30, 31,
-	31, 31, 31, 31, 32, 32, 32, 32, 33, 33,
-	33, 33, 33, 33, 33, 33, 37, 37, 37, 37,
-	37, 37, 36, 36, 36, 35, 35, 35, 35, 35,
-	35, 34, 34, 38, 38, 39, 39, 39, 41, 41,
-	40, 40, 44, 43, 43, 43, 42, 42, 42, 42,
+	5, 5, 5, 5, 8, 8, 6, 6, 7, 7,
+	7, 7, 15, 16, 16, 16, 16, 16, 16, 16,
+	17, 17, 17, 18, 18, 18, 18, 18, 18, 18,
+	18, 18, 18, 18, 9, 10, 10, 11, 12, 13,
+	13, 14, 14, 19, 19, 26, 26, 26, 26, 26,
+	27, 20, 20, 20, 20, 20, 21, 21, 23, 24,
+	24, 24, 24, 24, 25, 25, 25, 22, 28, 28,
+	28, 28, 29, 29, 29, 30, 30, 31, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 31, 31,
+	31, 31, 31, 32, 32, 32, 33, 33, 33, 33,
+	33, 33, 33, 33, 35, 35, 34, 34, 34, 34,
+	38, 38, 37, 37, 39, 39, 36, 36, 41, 41,
+	42, 43, 51, 51, 51, 52, 53, 53, 54, 54,
+	55, 55, 55, 55, 55, 55, 55, 55, 56, 56,
+	56, 56, 57, 57, 57, 58, 58, 58, 44, 44,
+	44, 44, 44, 44, 44, 45, 45, 45, 45, 45,
+	46, 46, 46, 46, 46, 46, 46, 46, 46, 46,
+	47, 47, 48, 48, 48, 48, 48, 49, 49, 49,
+	49, 50, 50, 50, 50, 50, 50, 50, 50, 62,
+	62, 62, 62, 62, 62, 61, 61, 61, 60, 60,
+	60, 60, 60, 60, 59, 59, 63, 63, 64, 64,
+	64, 40, 40, 66, 66, 65, 65, 67, 67, 70,
+	69, 69, 69, 68, 68, 68, 68,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 1, 2, 2, 3, 2, 3, 0, 1, 1,
-	1, 1, 1, 0, 1, 1, 1, 2, 2, 1,
-	13, 12, 9, 8, 6, 5, 6, 5, 4, 6,
-	4, 1, 1, 1, 1, 1, 1, 2, 5, 1,
-	1, 2, 3, 4, 5, 4, 3, 3, 5, 5,
-	3, 3, 3, 5, 7, 5, 4, 7, 5, 6,
-	7, 7, 8, 7, 8, 8, 9, 7, 7, 0,
-	1, 1, 2, 2, 4, 4, 3, 0, 1, 4,
-	4, 1, 1, 5, 3, 7, 8, 8, 9, 12,
-	11, 2, 5, 7, 3, 5, 6, 4, 5, 5,
-	6, 4, 5, 4, 4, 4, 4, 4, 6, 8,
-	7, 3, 6, 10, 5, 1, 1, 1, 1, 1,
-	0, 1, 4, 1, 3, 2, 2, 5, 2, 6,
-	2, 4, 2, 3, 1, 1, 3, 1, 2, 1,
-	1, 1, 1, 1, 0, 3, 6, 6, 5, 5,
-	7, 8, 6, 5, 5, 7, 8, 3, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 1, 2, 2,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	2, 2, 4, 4, 4, 2, 2, 2, 1, 13,
+	12, 9, 8, 6, 5, 6, 5, 6, 5, 6,
+	5, 4, 6, 4, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 5, 1, 1, 2, 3,
+	4, 5, 4, 3, 3, 5, 5, 3, 3, 3,
+	5, 7, 5, 4, 7, 5, 6, 7, 7, 8,
+	7, 8, 8, 9, 7, 5, 7, 4, 7, 1,
+	3, 4, 5, 7, 11, 0, 1, 1, 2, 2,
+	4, 0, 1, 1, 2, 2, 4, 4, 6, 0,
+	1, 1, 2, 2, 4, 6, 6, 3, 0, 1,
+	4, 4, 0, 1, 4, 1, 2, 1, 1, 5,
+	3, 7, 8, 8, 9, 12, 11, 3, 5, 2,
+	5, 7, 3, 5, 6, 4, 5, 5, 6, 4,
+	5, 4, 4, 4, 4, 4, 6, 8, 7, 3,
+	3, 5, 6, 6, 10, 5, 6, 6, 1, 1,
+	1, 1, 1, 0, 1, 4, 1, 1, 3, 2,
+	2, 5, 2, 6, 1, 4, 4, 1, 6, 3,
+	4, 7, 0, 1, 0, 2, 2, 3, 1, 1,
+	3, 1, 0, 3, 6, 6, 0, 2, 1, 4,
+	1, 3, 2, 1, 3, 4, 5, 6, 0, 2,
+	3, 6, 0, 3, 6, 0, 3, 6, 2, 1,
+	1, 1, 1, 1, 1, 0, 3, 6, 2, 5,
+	6, 5, 5, 7, 8, 6, 5, 5, 7, 8,
+	3, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 2, 2, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 0, 1, 2, 1, 1, 0, 1,
-	1, 2, 1, 2, 1, 1, 0, 2, 1, 1,
+	3, 3, 3, 3, 3, 3, 0, 1, 2, 1,
+	1, 1, 3, 1, 1, 0, 1, 1, 2, 1,
+	2, 1, 1, 0, 2, 1, 1,
 }
-var yyChk = [...]int{
-
-	-1000, -1, -38, -4, -39, 80, -40, -44, 84, -5,
-	-6, 38, 39, 10, 12, -8, 29, 47, 55, 56,
-	-12, -13, -14, -7, -9, -19, -10, -11, 28, 13,
-	15, 44, 58, 49, -24, -27, 9, 81, -23, 76,
-	4, -26, 54, 23, 50, 57, 74, -29, -30, -31,
-	-32, -33, 11, -18, -25, 66, 5, 6, 24, 25,
-	26, 51, 83, 68, 72, 69, -37, -36, -35, -34,
-	-38, -39, -40, -44, -18, -19, 76, -19, 74, 4,
-	-19, 76, 76, 14, 60, 52, 62, 27, 76, 81,
-	16, 79, 51, 40, 41, 32, 33, 37, 34, 35,
-	36, 69, 70, 71, 42, 43, 72, 65, 66, 67,
-	17, 18, 63, 20, 64, 19, 22, 21, 74, 4,
-	-19, 74, -20, -19, 80, -6, 4, -19, -19, 79,
-	4, 76, 4, 67, 82, -41, -40, -21, 4, 69,
-	-23, 57, 48, 49, 81, -20, -19, 76, 81, 76,
-	76, 76, 74, 81, -41, -20, 4, 60, 59, 52,
-	78, 5, -19, -19, -19, -19, -19, -19, -5, -1,
-	76, 76, -19, -19, 13, 74, -19, -19, -19, -19,
-	-18, -19, 61, -19, 4, -19, -19, -19, -19, -19,
-	-19, -19, -19, -19, -19, -19, -19, -19, -19, -19,
-	-19, -19, -19, -19, -19, -19, -19, -19, -19, 74,
-	74, -1, -40, 16, 78, 74, 80, -19, 80, 74,
-	59, -19, 74, -20, 76, 4, -23, -18, 74, 79,
-	-21, -21, 81, -21, 74, 82, 77, 77, -18, -19,
-	61, -19, -21, -21, 53, -41, -21, -28, -19, 60,
-	-18, 76, -18, -19, -41, 75, -18, -18, 77, 78,
-	77, -19, -1, 61, 8, 77, 82, 61, -19, -2,
-	-38, -1, 75, -19, -41, -1, 74, -19, 80, 80,
-	-19, -41, 4, 59, -40, 77, 8, -20, 67, 78,
-	-42, -40, -41, 4, -21, -41, 60, 8, 77, 82,
-	61, -19, 77, 77, 77, 78, 4, -28, 82, -42,
-	78, 61, -18, -20, -19, -26, 30, 8, 77, 8,
-	77, -19, 74, 75, -19, 77, 62, -19, 82, 82,
-	61, -39, 75, -3, -8, -19, -6, -9, -7, 75,
-	74, 4, 75, -1, 74, 74, -19, 74, -19, 80,
-	-15, -17, -16, 46, 45, 4, -22, 4, 74, 77,
-	77, 8, 4, -40, 82, -18, 82, -22, -18, 77,
-	62, -19, 82, 82, 61, -19, 78, -42, -21, 75,
-	-41, -19, 77, 4, 74, 77, 77, 77, -1, 62,
-	82, 61, -19, -3, -1, 75, -1, -1, 74, -1,
-	74, 74, -19, -41, -16, -17, 61, -19, -18, -40,
-	-43, 78, -21, -1, 74, 74, 77, 76, -42, -21,
-	-41, -40, 62, 82, 61, -19, 77, 78, -19, 75,
-	74, -19, 74, -1, 75, -19, 82, 75, 75, 75,
-	-1, 75, -1, -1, 74, 75, -1, 61, 61, 75,
-	4, -40, 75, -1, -1, 74, -20, 75, 75, -19,
-	82, -19, 77, -41, 61, -1, 75, 82, 75, 75,
-	75, -1, -1, -1, -21, 75, 75, -1, 8, 77,
-	82, 77, -28, -19, 75, 31, 75, 75, 77, 74,
-	-42, 31, 74, 74, -1, 75, 74, -1, -1, 75,
-	-1, 75, 75, 75,
+
+var yyChk = [...]int16{
+	-1000, -1, -63, -4, -64, 95, -67, -70, 98, -5,
+	-6, 39, 40, 4, 10, 12, 13, -8, 30, 49,
+	50, 60, 61, -17, -18, -19, -23, -7, -9, -10,
+	-11, -12, -31, -15, -16, 29, 14, 16, 45, 46,
+	63, 52, 53, 67, 68, -41, -44, 9, 71, 90,
+	-36, 74, -43, 59, 24, 55, 65, 62, 91, -46,
+	-47, -48, -49, -50, 11, -28, -42, 81, 5, 6,
+	73, 25, 26, 27, 56, 97, 83, 87, 84, -62,
+	-61, -60, -59, -63, -64, -67, -70, 4, 4, 76,
+	70, 74, -28, -31, 4, 74, -31, -31, 91, 4,
+	-31, 4, -31, 74, 74, 15, 75, 57, 77, 28,
+	74, 90, 17, 54, 89, 69, 56, 41, 42, 33,
+	34, 38, 35, 36, 37, 84, 85, 86, 43, 44,
+	87, 80, 81, 82, 18, 19, 78, 21, 79, 20,
+	23, 22, 91, 4, -31, 91, -32, -31, 95, -6,
+	4, -31, 91, -31, 89, 4, 4, 4, 4, 74,
+	4, 82, -28, 96, -65, -67, -33, 4, 53, 84,
+	-36, 62, 51, 52, 90, -32, -31, 90, 74, 74,
+	74, -31, 91, 90, -65, -32, 4, 75, 64, 57,
+	94, 5, -31, -31, -31, -31, -31, -31, -5, -65,
+	-40, -31, 72, -28, -1, 74, 74, 74, 74, -31,
+	-31, 14, 91, -31, -31, -31, -31, -28, -31, 76,
+	-31, -33, 74, 4, -65, -31, -31, -31, -31, -31,
+	-31, -31, -31, -31, -31, -31, -31, -31, -31, -31,
+	-31, -31, -31, -31, -31, -31, -31, -31, -31, -66,
+	91, 69, 91, -1, -67, 17, 94, 91, 95, -31,
+	95, 91, 89, -65, 64, -31, -66, -66, 75, -66,
+	-32, 74, 4, 93, -36, -29, -30, -31, 8, -66,
+	89, -33, -33, 90, -33, 91, 96, 93, 93, -31,
+	76, -31, -33, -33, 58, 91, -65, -33, -45, -31,
+	8, 75, -28, 74, -28, -31, -65, -18, -19, -23,
+	-1, 8, 93, 92, -28, -28, -28, -28, 93, 94,
+	93, -31, -1, 76, 8, 93, 96, 76, -31, -33,
+	-28, -38, 4, -2, -63, -1, 92, -31, -65, -1,
+	91, -31, 95, 95, -31, -65, 74, -24, -22, -25,
+	48, 47, 4, 64, -67, -65, -31, -65, 93, 8,
+	-32, 82, 70, 94, -68, -67, -31, -65, 4, -33,
+	-65, 75, 96, 76, -31, 93, 93, 93, 94, 4,
+	-65, -45, 96, -68, 94, 76, -31, -28, -32, -31,
+	-43, 92, 93, 77, 31, 8, 93, 8, 93, 8,
+	93, 8, 93, -31, 91, 92, -31, 93, 77, -31,
+	96, 96, 76, 93, 94, -68, -68, 94, 76, -64,
+	92, -3, -8, -31, -6, -9, -10, -11, -12, -7,
+	92, 91, 4, 92, -1, 91, 91, -31, 91, -31,
+	95, -20, -22, -21, 47, 58, -65, -25, -22, 76,
+	-31, -28, 4, -34, 4, 92, -13, -14, 4, -32,
+	91, 93, 93, 8, 4, -40, -65, -67, 96, -29,
+	96, -34, -28, -31, 96, 96, 76, -31, 94, -51,
+	-68, -33, 92, -65, -31, 93, 77, 4, 91, 93,
+	93, 93, 93, 93, -1, 77, 96, 76, -31, 92,
+	92, -65, -65, -3, -1, 92, -1, -1, 91, -1,
+	91, 91, -31, -65, -21, -22, -31, -28, 93, 92,
+	-1, 76, 57, 57, -67, -69, 94, -33, -65, -67,
+	74, -68, 94, -1, 91, 91, 93, 74, -30, -68,
+	-33, -65, -67, 96, 76, -31, 93, 94, -31, 92,
+	-52, 48, 47, 92, -66, -31, 8, -66, -1, 92,
+	-31, 96, 4, -31, 92, 92, 92, -1, 92, -1,
+	-1, 91, 92, 76, 76, 91, -1, -31, -31, 92,
+	4, -67, -37, 6, 92, -14, -32, 92, 92, -1,
+	-1, 91, -32, 92, 92, -31, 96, -31, 93, -63,
+	76, -54, -55, 4, 54, -44, 90, 91, 81, -65,
+	76, -31, -1, 92, 96, 76, 92, 92, 92, -1,
+	-1, -1, -65, 76, 76, -33, -39, 75, 93, 8,
+	92, 92, -1, 8, 93, 96, 93, -65, -53, 94,
+	14, 54, -66, -33, 66, -65, -65, 5, -45, -31,
+	92, 32, -65, 92, -26, -22, -27, 47, -1, -1,
+	-37, -31, 93, 92, 93, 91, -31, 76, -65, -31,
+	-33, -65, -44, -56, -54, 8, -57, -44, -68, 32,
+	91, -31, -65, -27, -22, -35, -33, -39, 91, -1,
+	-63, -65, -55, -58, 4, 96, 94, -68, 4, -68,
+	94, 76, 92, 91, -1, 92, 76, 94, -1, 92,
+	-31, -68, 94, 76, -65, -65, 92, -65, -55, -1,
+	92, -1, -65, 92, 92, -65, -55, 8, -44, 92,
+	-33, 4, 4, 76, 76, -65, -55, -55,
 }
-var yyDef = [...]int{
-
-	193, -2, -2, 193, 194, 197, 196, 200, 202, 3,
-	14, 15, 16, 77, 0, 19, 0, 0, 0, 0,
-	31, 32, 33, 34, 35, -2, 39, 40, 0, 0,
-	-2, 0, 0, 0, 81, 82, 0, 198, 0, 120,
-	137, 135, 0, 0, 0, 0, 198, 115, 116, 117,
-	118, 119, 120, 0, 134, 0, 139, 140, 141, 142,
-	143, 0, 0, 0, 0, 0, 164, 165, 166, 167,
-	2, -2, 195, 201, 17, 78, 0, 18, 193, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 77, 0,
-	0, 0, 0, 168, 169, 0, 0, 0, 0, 0,
+
+var yyDef = [...]int16{
+	286, -2, -2, 286, 287, 290, 289, 297, 299, 3,
+	17, 18, 19, 201, 118, 0, 0, 28, 0, 0,
+	0, 0, 0, 44, 45, 46, 47, 48, 49, 50,
+	51, 52, -2, 56, 57, 0, 0, -2, 0, 0,
+	0, 0, 0, 0, 0, 127, 128, 0, 118, 295,
+	0, 173, 199, 0, 0, 0, 0, 0, 295, 168,
+	169, 170, 171, 172, 173, 0, 198, 0, 229, 230,
+	231, 232, 233, 234, 0, 0, 0, 0, 0, 257,
+	258, 259, 260, 2, -2, 288, 298, 20, 21, 295,
+	0, 118, 25, 119, 201, 0, 26, 27, 286, 201,
+	0, 201, 0, 0, 0, 0, 0, 0, 0, 0,
+	118, 0, 0, 0, 0, 295, 0, 261, 262, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 37, 0,
-	0, 193, 0, 78, 0, 0, -2, 0, 41, 0,
-	0, 120, 0, 0, -2, 77, 199, 0, 123, 0,
-	0, 0, 0, 0, 0, 0, 0, 77, 0, 0,
-	0, 0, 198, 0, 144, 0, 121, 77, 77, 0,
-	198, 138, 159, 158, 160, 161, 162, 163, 4, 0,
-	77, 77, 0, 0, 0, 193, 46, 51, 0, 84,
-	0, 0, 0, 111, 136, 157, 170, 171, 172, 173,
-	174, 175, 176, 177, 178, 179, 180, 181, 182, 183,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	193, 0, 196, 0, 198, 193, 0, 0, 0, 198,
-	0, 42, 0, 0, 120, 0, 133, 206, 198, 0,
-	125, 126, 0, 128, 198, 132, 0, 94, 0, 0,
-	0, 0, 0, 0, 0, 144, 0, 206, 0, 77,
-	47, 120, 50, 52, 0, 0, 0, 0, 28, 0,
-	30, 0, 0, 0, 0, 101, 104, 0, 0, 0,
-	-2, 0, 56, 0, 0, 0, 193, 0, 0, 0,
-	0, 69, 43, 0, 0, 0, 0, 0, 0, -2,
-	0, 208, 77, 124, 0, 0, 77, 0, 97, 103,
-	0, 0, 105, 106, 107, 0, 0, 206, 0, 0,
-	-2, 0, 45, 0, 79, -2, 0, 0, -2, 0,
-	-2, 0, 193, 55, 83, 99, 102, 0, 153, 154,
-	0, -2, 38, 5, 8, -2, 10, 11, 12, 53,
-	193, 122, 58, 0, 193, 193, 0, 193, 0, 0,
-	198, 70, 71, 0, 77, 44, 0, 0, 193, 0,
-	0, 0, 0, -2, 92, 206, 0, 198, 48, 95,
-	98, 0, 148, 149, 0, 0, 0, 0, 0, 114,
-	0, 145, 49, 0, 193, -2, -2, 29, 0, 100,
-	152, 0, 0, 6, 0, 59, 0, 0, 193, 0,
-	193, 193, 0, 0, 72, 73, 193, 78, 0, 204,
-	0, 205, 130, 0, 193, 193, 0, 120, 0, 127,
-	0, -2, 96, 147, 0, 0, 108, 0, 0, 112,
-	198, 0, 193, 0, 54, 0, 155, 57, 60, 61,
-	0, 63, 0, 0, 193, 68, 76, 193, 193, 67,
-	0, 203, 85, 0, 0, 193, 0, 93, 129, 0,
-	150, 0, 110, 144, 0, 0, 23, 156, 62, 64,
-	65, 0, 74, 75, 131, 86, 87, 0, 0, 0,
-	151, 109, 206, 146, 22, 0, 66, 88, 0, 193,
-	0, 0, 193, 193, 0, 113, 193, 0, 0, 90,
-	0, 21, 89, 20,
+	0, 0, 54, 0, 0, -2, 0, 119, 0, 0,
+	-2, 0, 295, 58, 0, 0, 0, 0, 0, 173,
+	0, 0, 0, -2, 122, 296, 0, 176, 177, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 295, 0, 235, 0, 174, 118, 118, 0,
+	295, 228, 252, 251, 253, 254, 255, 256, 4, 0,
+	137, 291, 286, 0, 0, 118, 118, 118, 118, 0,
+	0, 0, 286, 63, 68, 0, 130, 0, 0, 0,
+	159, 160, 0, 200, 118, 250, 263, 264, 265, 266,
+	267, 268, 269, 270, 271, 272, 273, 274, 275, 276,
+	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	293, 294, 286, 0, -2, 0, 295, 286, 0, 0,
+	0, 295, 0, 109, 0, 59, 0, 295, 0, 295,
+	0, 173, 0, 0, 197, 303, 123, 125, 0, 295,
+	0, 179, 180, 0, 182, 295, 196, 0, 142, 0,
+	0, 0, 0, 0, 0, 295, 235, 0, 303, 0,
+	0, 118, 64, 173, 67, 69, 0, 22, 23, 24,
+	0, 0, 145, 0, 0, 0, 0, 0, 41, 0,
+	43, 0, 0, 0, 0, 149, 152, 0, 0, 0,
+	303, 303, 201, 0, -2, 0, 73, 0, 0, 0,
+	-2, 0, 0, 0, 0, 101, 0, 295, 110, 111,
+	0, 118, 60, 0, 0, 0, 87, 173, 0, 0,
+	0, 0, 0, -2, 0, 305, 126, 122, 178, 0,
+	0, 118, 151, 0, 0, 153, 154, 155, 0, 0,
+	202, 303, 0, 0, -2, 0, 238, 62, 0, 120,
+	-2, 292, 143, 146, 0, 0, -2, 0, -2, 0,
+	-2, 0, -2, 0, 286, 72, 129, 147, 150, 0,
+	246, 247, 0, 161, -2, 0, 0, -2, 295, -2,
+	55, 5, 8, -2, 10, 11, 12, 13, 14, 15,
+	70, 286, 175, 75, 0, 286, -2, 0, -2, 0,
+	0, 295, 102, 103, 118, 0, 0, 112, 113, 286,
+	119, 0, 61, 0, 187, 85, 295, 89, 0, 303,
+	286, 0, 0, 0, 0, 138, 0, -2, 140, 303,
+	0, 295, 65, 0, 241, 242, 0, 0, 0, 0,
+	0, 0, 165, 0, 236, 66, 144, 0, 286, -2,
+	-2, -2, -2, 42, 0, 148, 245, 0, 0, 166,
+	167, 0, 0, 6, 0, 76, 0, 0, 286, 0,
+	286, -2, 0, 0, 104, 105, 119, 0, 0, 108,
+	117, 286, 0, 0, 301, 0, 302, 192, 0, 296,
+	173, 0, -2, 0, 286, 286, 0, 173, 124, 0,
+	181, 0, -2, 240, 0, 0, 156, 0, 0, 162,
+	286, 0, 0, 163, 295, 0, 0, 286, 0, 71,
+	0, 248, 0, 190, 74, 77, 78, 0, 80, 0,
+	0, 286, 93, 286, 286, 295, 114, 0, 0, 84,
+	189, 300, 194, 193, 86, 90, 0, 88, 131, 0,
+	0, 286, 0, 141, 183, 0, 243, 0, 158, 203,
+	295, 206, 208, 210, 0, 213, 295, 295, 0, 235,
+	0, 239, 0, 32, 249, 295, 79, 81, 82, 0,
+	106, 107, 95, 286, 286, 192, 186, 0, 91, 0,
+	132, 133, 0, 0, 0, 244, 157, 0, 0, 295,
+	0, 0, 295, 212, 0, 218, 222, 228, 303, 237,
+	31, 0, 0, 83, 295, 96, 97, 0, 115, 116,
+	194, 195, 92, 134, 0, 286, 286, 295, 0, 207,
+	211, 225, 214, 0, 303, 0, 303, 0, 0, 0,
+	286, 191, 0, 98, 99, 0, 184, 188, 286, 0,
+	204, 0, 209, 303, 0, 215, -2, 219, 295, 0,
+	-2, 0, 164, 286, 0, 94, 286, 295, 0, 136,
+	205, 0, -2, 0, 0, 220, 216, 0, 223, 0,
+	30, 100, 0, 135, 217, 0, 226, 0, 0, 29,
+	185, 0, 295, 0, 0, 221, 224, 227,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	84, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	98, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 83, 3, 3, 3, 71, 72, 3,
-	76, 77, 69, 65, 78, 66, 79, 70, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 61, 80,
-	63, 60, 64, 62, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 97, 3, 3, 3, 86, 87, 3,
+	74, 93, 84, 80, 94, 81, 89, 85, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 76, 95,
+	78, 75, 79, 77, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 81, 3, 82, 68, 3, 3, 3, 3, 3,
+	3, 90, 3, 96, 83, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 74, 67, 75,
+	3, 3, 3, 91, 82, 92,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 55, 56, 57, 58, 59, 73,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 88,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -1073,9 +1491,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -1085,13 +1503,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -1122,30 +1540,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -1200,7 +1618,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -1211,8 +1629,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -1225,7 +1643,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -1234,18 +1652,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -1267,10 +1685,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -1306,7 +1724,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -1317,16 +1735,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -1334,19 +1752,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:117
+//line parser.go.y:165
 		{
 			yyVAL.compstmt = nil
 		}
 	case 2:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:121
+//line parser.go.y:169
 		{
 			yyVAL.compstmt = yyDollar[1].stmts
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:127
+//line parser.go.y:175
 		{
 			if yyDollar[2].stmt != nil {
 				yyVAL.stmts = &ast.StmtsStmt{Stmts: []ast.Stmt{yyDollar[2].stmt}}
@@ -1357,7 +1775,7 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:136
+//line parser.go.y:184
 		{
 			if yyDollar[3].stmt != nil {
 				if yyDollar[1].stmts == nil {
@@ -1373,7 +1791,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:152
+//line parser.go.y:200
 		{
 			if yyDollar[2].modstmt != nil {
 				yyVAL.modstmts = &ast.StmtsStmt{Stmts: []ast.Stmt{yyDollar[2].modstmt}}
@@ -1384,7 +1802,7 @@ yydefault:
 		}
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:161
+//line parser.go.y:209
 		{
 			if yyDollar[3].modstmt != nil {
 				if yyDollar[1].modstmts == nil {
@@ -1400,272 +1818,395 @@ yydefault:
 		}
 	case 7:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:177
+//line parser.go.y:225
 		{
 			yyVAL.modstmt = nil
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:181
+//line parser.go.y:229
 		{
 			yyVAL.modstmt = yyDollar[1].stmt_module
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:185
+//line parser.go.y:233
 		{
 			yyVAL.modstmt = &ast.ExprStmt{Expr: yyDollar[1].expr}
 			yyVAL.modstmt.SetPosition(yyDollar[1].expr.Position())
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:190
+//line parser.go.y:238
 		{
 			yyVAL.modstmt = yyDollar[1].stmt_var_or_lets
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:194
+//line parser.go.y:242
 		{
 			yyVAL.modstmt = yyDollar[1].stmt_struct
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:198
+//line parser.go.y:246
 		{
-			yyVAL.modstmt = yyDollar[1].stmt_import
+			yyVAL.modstmt = yyDollar[1].stmt_interface
 		}
 	case 13:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:250
+		{
+			yyVAL.modstmt = yyDollar[1].stmt
+		}
+	case 14:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:254
+		{
+			yyVAL.modstmt = yyDollar[1].stmt
+		}
+	case 15:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:258
+		{
+			yyVAL.modstmt = yyDollar[1].stmt_import
+		}
+	case 16:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:205
+//line parser.go.y:265
 		{
 			yyVAL.stmt = nil
 		}
-	case 14:
+	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:209
+//line parser.go.y:269
 		{
 			yyVAL.stmt = yyDollar[1].stmt_var_or_lets
 		}
-	case 15:
+	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:213
+//line parser.go.y:273
 		{
 			yyVAL.stmt = &ast.BreakStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 16:
+	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:218
+//line parser.go.y:278
 		{
 			yyVAL.stmt = &ast.ContinueStmt{}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 17:
+	case 20:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:283
+		{
+			yyVAL.stmt = &ast.BreakStmt{Label: yyDollar[2].tok.Lit}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+			addLabelRef(yylex, yyDollar[1].tok, yyDollar[2].tok.Lit, false)
+		}
+	case 21:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:289
+		{
+			yyVAL.stmt = &ast.ContinueStmt{Label: yyDollar[2].tok.Lit}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+			addLabelRef(yylex, yyDollar[1].tok, yyDollar[2].tok.Lit, true)
+		}
+	case 22:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:295
+		{
+			if !setLabel(yylex, yyDollar[1].tok, yyDollar[4].stmt_for) {
+				return 1
+			}
+			yyVAL.stmt = yyDollar[4].stmt_for
+		}
+	case 23:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:302
+		{
+			if !setLabel(yylex, yyDollar[1].tok, yyDollar[4].stmt_switch) {
+				return 1
+			}
+			yyVAL.stmt = yyDollar[4].stmt_switch
+		}
+	case 24:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:309
+		{
+			if !setLabel(yylex, yyDollar[1].tok, yyDollar[4].stmt_select) {
+				return 1
+			}
+			yyVAL.stmt = yyDollar[4].stmt_select
+		}
+	case 25:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:223
+//line parser.go.y:316
 		{
 			yyVAL.stmt = &ast.ReturnStmt{Exprs: yyDollar[2].exprs}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 18:
+	case 26:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:228
+//line parser.go.y:321
 		{
 			yyVAL.stmt = &ast.ThrowStmt{Expr: yyDollar[2].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 19:
+	case 27:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:326
+		{
+			yyVAL.stmt = &ast.YieldStmt{Expr: yyDollar[2].expr}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+			if l, ok := yylex.(*Lexer); ok {
+				l.yields = append(l.yields, yyDollar[1].tok.Position())
+			}
+		}
+	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:233
+//line parser.go.y:332
 		{
 			yyVAL.stmt = yyDollar[1].stmt_module
 		}
-	case 20:
+	case 29:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser.go.y:237
+//line parser.go.y:336
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt, Finally: yyDollar[12].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 21:
+	case 30:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser.go.y:242
+//line parser.go.y:341
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt, Finally: yyDollar[11].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 22:
+	case 31:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:247
+//line parser.go.y:346
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Var: yyDollar[6].tok.Lit, Catch: yyDollar[8].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 23:
+	case 32:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:252
+//line parser.go.y:351
 		{
 			yyVAL.stmt = &ast.TryStmt{Try: yyDollar[3].compstmt, Catch: yyDollar[7].compstmt}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 24:
+	case 33:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:257
+//line parser.go.y:356
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 25:
+	case 34:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:262
+//line parser.go.y:361
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 26:
+	case 35:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:267
+//line parser.go.y:366
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, VarArg: true, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 27:
+	case 36:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:272
+//line parser.go.y:371
 		{
 			yyVAL.stmt = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, Go: true}}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 28:
+	case 37:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:376
+		{
+			yyVAL.stmt = &ast.DeferStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, VarArg: true, Defer: true}}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 38:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:381
+		{
+			yyVAL.stmt = &ast.DeferStmt{Expr: &ast.CallExpr{Name: yyDollar[2].tok.Lit, SubExprs: yyDollar[4].exprs, Defer: true}}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 39:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:386
+		{
+			yyVAL.stmt = &ast.DeferStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, VarArg: true, Defer: true}}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 40:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:391
+		{
+			yyVAL.stmt = &ast.DeferStmt{Expr: &ast.AnonCallExpr{Expr: yyDollar[2].expr, SubExprs: yyDollar[4].exprs, Defer: true}}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 41:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:277
+//line parser.go.y:396
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 29:
+	case 42:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:282
+//line parser.go.y:401
 		{
 			yyVAL.stmt = &ast.DeleteStmt{Item: yyDollar[3].expr, Key: yyDollar[5].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 30:
+	case 43:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:287
+//line parser.go.y:406
 		{
 			yyVAL.stmt = &ast.CloseStmt{Expr: yyDollar[3].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 31:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:292
+//line parser.go.y:411
 		{
 			yyVAL.stmt = yyDollar[1].stmt_if
 		}
-	case 32:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:296
+//line parser.go.y:415
 		{
 			yyVAL.stmt = yyDollar[1].stmt_for
 		}
-	case 33:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:300
+//line parser.go.y:419
 		{
 			yyVAL.stmt = yyDollar[1].stmt_switch
 		}
-	case 34:
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:423
+		{
+			yyVAL.stmt = yyDollar[1].stmt_select
+		}
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:304
+//line parser.go.y:427
 		{
 			yyVAL.stmt = yyDollar[1].stmt_import
 		}
-	case 35:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:308
+//line parser.go.y:431
 		{
 			yyVAL.stmt = yyDollar[1].stmt_struct
 		}
-	case 36:
+	case 50:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:435
+		{
+			yyVAL.stmt = yyDollar[1].stmt_interface
+		}
+	case 51:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:439
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:443
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:312
+//line parser.go.y:447
 		{
 			yyVAL.stmt = &ast.ExprStmt{Expr: yyDollar[1].expr}
 			yyVAL.stmt.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 37:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:319
+//line parser.go.y:454
 		{
 			yylex.Error("can't create anonymous module")
 			return 1
 		}
-	case 38:
+	case 55:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:324
+//line parser.go.y:459
 		{
 			yyVAL.stmt_module = &ast.ModuleStmt{Name: yyDollar[2].tok.Lit, Stmt: yyDollar[4].modstmts}
 			yyVAL.stmt_module.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 39:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:331
+//line parser.go.y:466
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_var
 		}
-	case 40:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:335
+//line parser.go.y:470
 		{
 			yyVAL.stmt_var_or_lets = yyDollar[1].stmt_lets
 		}
-	case 41:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:341
+//line parser.go.y:476
 		{
 			yyVAL.stmt_import = &ast.ImportStmt{Name: yyDollar[2].expr}
 			yyVAL.stmt_import.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 42:
+	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:347
+//line parser.go.y:482
 		{
 			yyVAL.stmt_import = &ast.ImportStmt{Name: yyDollar[3].expr, Local: true}
 			yyVAL.stmt_import.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 43:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:353
+//line parser.go.y:488
 		{
 			yyVAL.stmt_import = &ast.ImportStmt{Name: yyDollar[2].expr, As: yyDollar[4].tok.Lit}
 			yyVAL.stmt_import.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 44:
+	case 61:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:359
+//line parser.go.y:494
 		{
 			yyVAL.stmt_import = &ast.ImportStmt{Name: yyDollar[3].expr, As: yyDollar[5].tok.Lit, Local: true}
 			yyVAL.stmt_import.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 45:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:366
+//line parser.go.y:501
 		{
 			yyVAL.stmt_var = &ast.VarStmt{Names: yyDollar[2].expr_idents, Exprs: yyDollar[4].exprs}
 			yyVAL.stmt_var.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 46:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:373
+//line parser.go.y:508
 		{
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{yyDollar[3].expr}}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 47:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:378
+//line parser.go.y:513
 		{
 			if len(yyDollar[1].exprs) == 2 && len(yyDollar[3].exprs) == 1 {
 				if _, ok := yyDollar[3].exprs[0].(*ast.ItemExpr); ok {
@@ -1677,9 +2218,9 @@ yydefault:
 				yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyDollar[1].exprs, RHSS: yyDollar[3].exprs}
 			}
 		}
-	case 48:
+	case 65:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:390
+//line parser.go.y:525
 		{
 			yyS := make([]ast.Expr, len(yyDollar[2].expr_idents))
 			for i, yyv := range yyDollar[2].expr_idents {
@@ -1687,9 +2228,9 @@ yydefault:
 			}
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyS, RHSS: yyDollar[5].exprs, Unpack: true}
 		}
-	case 49:
+	case 66:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:399
+//line parser.go.y:534
 		{
 			yyS := make([]ast.Expr, len(yyDollar[4].expr_idents))
 			for i, yyv := range yyDollar[4].expr_idents {
@@ -1698,9 +2239,9 @@ yydefault:
 			yyVAL.stmt_lets = &ast.LetsStmt{LHSS: yyS, RHSS: yyDollar[1].exprs, Unpack: true}
 			yyVAL.stmt_lets.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 50:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:409
+//line parser.go.y:544
 		{
 			// for maps
 			if len(yyDollar[3].exprs) == 2 && len(yyDollar[1].exprs) == 1 {
@@ -1714,16 +2255,16 @@ yydefault:
 			}
 			yyVAL.stmt_lets.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 51:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:423
+//line parser.go.y:558
 		{
 			yyVAL.stmt_lets = &ast.ChanStmt{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.stmt_lets.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 52:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:428
+//line parser.go.y:563
 		{
 			if len(yyDollar[1].exprs) == 2 {
 				chanStmt := &ast.ChanStmt{LHS: yyDollar[1].exprs[0].(ast.Expr), OkExpr: yyDollar[1].exprs[1].(ast.Expr), RHS: yyDollar[3].expr}
@@ -1736,23 +2277,23 @@ yydefault:
 				yyVAL.stmt_lets.SetPosition(yyDollar[2].tok.Position())
 			}
 		}
-	case 53:
+	case 70:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:442
+//line parser.go.y:577
 		{
 			yyVAL.stmt_if = &ast.IfStmt{If: yyDollar[2].expr, Then: yyDollar[4].compstmt, Else: nil}
 			yyVAL.stmt_if.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 54:
+	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:447
+//line parser.go.y:582
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			ifStmt.ElseIf = append(ifStmt.ElseIf, &ast.IfStmt{If: yyDollar[4].expr, Then: yyDollar[6].compstmt})
 		}
-	case 55:
+	case 72:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:452
+//line parser.go.y:587
 		{
 			ifStmt := yyDollar[1].stmt_if.(*ast.IfStmt)
 			if ifStmt.Else != nil {
@@ -1761,16 +2302,16 @@ yydefault:
 			}
 			ifStmt.Else = yyDollar[4].compstmt
 		}
-	case 56:
+	case 73:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:463
+//line parser.go.y:598
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Stmt: yyDollar[3].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 57:
+	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:468
+//line parser.go.y:603
 		{
 			if len(yyDollar[2].expr_idents) < 1 {
 				yylex.Error("missing identifier")
@@ -1783,116 +2324,225 @@ yydefault:
 			yyVAL.stmt_for = &ast.ForStmt{Vars: yyDollar[2].expr_idents, Value: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 58:
+	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:481
+//line parser.go.y:616
 		{
 			yyVAL.stmt_for = &ast.LoopStmt{Expr: yyDollar[2].expr, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 59:
+	case 76:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:486
+//line parser.go.y:621
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt: yyDollar[5].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 60:
+	case 77:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:491
+//line parser.go.y:626
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr3: yyDollar[4].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 61:
+	case 78:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:496
+//line parser.go.y:631
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 62:
+	case 79:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:501
+//line parser.go.y:636
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Expr2: yyDollar[3].expr, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 63:
+	case 80:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:506
+//line parser.go.y:641
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Stmt: yyDollar[6].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 64:
+	case 81:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:511
+//line parser.go.y:646
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr3: yyDollar[5].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 65:
+	case 82:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:516
+//line parser.go.y:651
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Stmt: yyDollar[7].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 66:
+	case 83:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:521
+//line parser.go.y:656
 		{
 			yyVAL.stmt_for = &ast.CForStmt{Stmt1: yyDollar[2].stmt_var_or_lets, Expr2: yyDollar[4].expr, Expr3: yyDollar[6].expr, Stmt: yyDollar[8].compstmt}
 			yyVAL.stmt_for.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 67:
+	case 84:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:528
+//line parser.go.y:663
 		{
 			yyVAL.stmt_struct = &ast.StructStmt{
 				Name: yyDollar[2].tok.Lit,
 				Body: yyDollar[5].type_data_struct,
 			}
 		}
-	case 68:
+	case 85:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:672
+		{
+			yyVAL.stmt_interface = &ast.InterfaceStmt{Name: yyDollar[2].tok.Lit}
+			yyVAL.stmt_interface.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 86:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:677
+		{
+			yyVAL.stmt_interface = &ast.InterfaceStmt{Name: yyDollar[2].tok.Lit, Methods: yyDollar[5].interface_methods}
+			yyVAL.stmt_interface.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 87:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:684
+		{
+			yyVAL.stmt = &ast.ConstStmt{Name: yyDollar[2].tok.Lit, Expr: yyDollar[4].expr}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 88:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:691
+		{
+			if member := duplicateName(yyDollar[5].expr_idents); member != "" {
+				yylex.Error("duplicate enum member " + member)
+				return 1
+			}
+			yyVAL.stmt = &ast.EnumStmt{Name: yyDollar[2].tok.Lit, Members: yyDollar[5].expr_idents}
+			yyVAL.stmt.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 89:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:702
+		{
+			yyVAL.interface_methods = []*ast.InterfaceMethod{yyDollar[1].interface_method}
+		}
+	case 90:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:706
+		{
+			yyVAL.interface_methods = append(yyDollar[1].interface_methods, yyDollar[3].interface_method)
+		}
+	case 91:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:712
+		{
+			yyVAL.interface_method = &ast.InterfaceMethod{Name: yyDollar[1].tok.Lit, Params: yyDollar[3].expr_idents}
+		}
+	case 92:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:716
+		{
+			yyVAL.interface_method = &ast.InterfaceMethod{Name: yyDollar[1].tok.Lit, Params: yyDollar[3].expr_idents, VarArg: true}
+		}
+	case 93:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:537
+//line parser.go.y:722
 		{
 			switchStmt := yyDollar[5].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Expr = yyDollar[2].expr
 			yyVAL.stmt_switch = switchStmt
 			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 69:
+	case 94:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser.go.y:729
+		{
+			switchStmt := yyDollar[9].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			switchStmt.Expr = yyDollar[2].expr
+			yyVAL.stmt_switch = switchStmt
+			yyVAL.stmt_switch.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 95:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:738
+		{
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{}
+		}
+	case 96:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:742
+		{
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{Default: yyDollar[1].stmt_switch_default}
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:746
+		{
+			yyVAL.stmt_type_switch_cases = &ast.TypeSwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_type_switch_case}}
+		}
+	case 98:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:750
+		{
+			switchStmt := yyDollar[1].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			switchStmt.Cases = append(switchStmt.Cases, yyDollar[2].stmt_type_switch_case)
+			yyVAL.stmt_type_switch_cases = switchStmt
+		}
+	case 99:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:756
+		{
+			switchStmt := yyDollar[1].stmt_type_switch_cases.(*ast.TypeSwitchStmt)
+			if switchStmt.Default != nil {
+				yylex.Error("multiple default statement")
+				return 1
+			}
+			switchStmt.Default = yyDollar[2].stmt_switch_default
+		}
+	case 100:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:767
+		{
+			yyVAL.stmt_type_switch_case = &ast.TypeSwitchCaseStmt{Types: yyDollar[2].type_datas, Stmt: yyDollar[4].compstmt}
+			yyVAL.stmt_type_switch_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:546
+//line parser.go.y:774
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{}
 		}
-	case 70:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:550
+//line parser.go.y:778
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Default: yyDollar[1].stmt_switch_default}
 		}
-	case 71:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:554
+//line parser.go.y:782
 		{
 			yyVAL.stmt_switch_cases = &ast.SwitchStmt{Cases: []ast.Stmt{yyDollar[1].stmt_switch_case}}
 		}
-	case 72:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:558
+//line parser.go.y:786
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			switchStmt.Cases = append(switchStmt.Cases, yyDollar[2].stmt_switch_case)
 			yyVAL.stmt_switch_cases = switchStmt
 		}
-	case 73:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:564
+//line parser.go.y:792
 		{
 			switchStmt := yyDollar[1].stmt_switch_cases.(*ast.SwitchStmt)
 			if switchStmt.Default != nil {
@@ -1901,41 +2551,119 @@ yydefault:
 			}
 			switchStmt.Default = yyDollar[2].stmt_switch_default
 		}
-	case 74:
+	case 106:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:575
+//line parser.go.y:803
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: []ast.Expr{yyDollar[2].expr}, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 75:
+	case 107:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:580
+//line parser.go.y:808
 		{
 			yyVAL.stmt_switch_case = &ast.SwitchCaseStmt{Exprs: yyDollar[2].exprs, Stmt: yyDollar[4].compstmt}
 			yyVAL.stmt_switch_case.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 76:
+	case 108:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:815
+		{
+			yyVAL.stmt_select = yyDollar[4].stmt_select_cases
+			yyVAL.stmt_select.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 109:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:822
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{}
+		}
+	case 110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:826
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{Default: yyDollar[1].stmt_switch_default}
+		}
+	case 111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:830
+		{
+			yyVAL.stmt_select_cases = &ast.SelectStmt{Cases: []ast.Stmt{yyDollar[1].stmt_select_case}}
+		}
+	case 112:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:834
+		{
+			selectStmt := yyDollar[1].stmt_select_cases.(*ast.SelectStmt)
+			selectStmt.Cases = append(selectStmt.Cases, yyDollar[2].stmt_select_case)
+			yyVAL.stmt_select_cases = selectStmt
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:840
+		{
+			selectStmt := yyDollar[1].stmt_select_cases.(*ast.SelectStmt)
+			if selectStmt.Default != nil {
+				yylex.Error("multiple default statement")
+				return 1
+			}
+			selectStmt.Default = yyDollar[2].stmt_switch_default
+		}
+	case 114:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:851
+		{
+			chanExpr, ok := yyDollar[2].expr.(*ast.ChanExpr)
+			if !ok {
+				yylex.Error("select case must be receive or send")
+				return 1
+			}
+			if chanExpr.LHS == nil {
+				yyVAL.stmt_select_case = &ast.SelectCaseStmt{Chan: chanExpr.RHS, Stmt: yyDollar[4].compstmt}
+			} else {
+				yyVAL.stmt_select_case = &ast.SelectCaseStmt{Chan: chanExpr.LHS, Send: chanExpr.RHS, Stmt: yyDollar[4].compstmt}
+			}
+			yyVAL.stmt_select_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 115:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:865
+		{
+			yyVAL.stmt_select_case = &ast.SelectCaseStmt{Chan: yyDollar[4].expr, LHS: yyDollar[2].expr, Stmt: yyDollar[6].compstmt}
+			yyVAL.stmt_select_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 116:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:870
+		{
+			if len(yyDollar[2].exprs) != 2 {
+				yylex.Error("select case must be receive or send")
+				return 1
+			}
+			yyVAL.stmt_select_case = &ast.SelectCaseStmt{Chan: yyDollar[4].expr, LHS: yyDollar[2].exprs[0], OkExpr: yyDollar[2].exprs[1], Stmt: yyDollar[6].compstmt}
+			yyVAL.stmt_select_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:587
+//line parser.go.y:881
 		{
 			yyVAL.stmt_switch_default = yyDollar[3].compstmt
 		}
-	case 77:
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:594
+//line parser.go.y:888
 		{
 			yyVAL.exprs = nil
 		}
-	case 78:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:598
+//line parser.go.y:892
 		{
 			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
 		}
-	case 79:
+	case 120:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:602
+//line parser.go.y:896
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -1943,9 +2671,9 @@ yydefault:
 			}
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
 		}
-	case 80:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:610
+//line parser.go.y:904
 		{
 			if len(yyDollar[1].exprs) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -1953,190 +2681,258 @@ yydefault:
 			}
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr_ident)
 		}
-	case 81:
+	case 122:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:914
+		{
+			yyVAL.exprs = nil
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:918
+		{
+			yyVAL.exprs = []ast.Expr{yyDollar[1].expr}
+		}
+	case 124:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:922
+		{
+			if len(yyDollar[1].exprs) == 0 {
+				yylex.Error("syntax error: unexpected ','")
+				return 1
+			}
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[4].expr)
+		}
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:933
+		{
+			yyVAL.expr = &ast.SpreadExpr{Expr: yyDollar[2].expr}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:620
+//line parser.go.y:940
 		{
 			yyVAL.expr = yyDollar[1].expr_member_or_ident
 		}
-	case 82:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:624
+//line parser.go.y:944
 		{
 			yyVAL.expr = yyDollar[1].expr_literals
 		}
-	case 83:
+	case 129:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:628
+//line parser.go.y:948
 		{
 			yyVAL.expr = &ast.TernaryOpExpr{Expr: yyDollar[1].expr, LHS: yyDollar[3].expr, RHS: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 84:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:633
+//line parser.go.y:953
 		{
 			yyVAL.expr = &ast.NilCoalescingOpExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 85:
+	case 131:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:638
+//line parser.go.y:958
 		{
-			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[6].compstmt}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[6].compstmt, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 86:
+	case 132:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:643
+//line parser.go.y:966
 		{
-			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[7].compstmt, VarArg: true}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Params: yyDollar[3].expr_idents, Stmt: yyDollar[7].compstmt, VarArg: true, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 87:
+	case 133:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:648
+//line parser.go.y:974
 		{
-			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[7].compstmt}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[7].compstmt, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 88:
+	case 134:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.go.y:653
+//line parser.go.y:982
 		{
-			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[8].compstmt, VarArg: true}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Name: yyDollar[2].tok.Lit, Params: yyDollar[4].expr_idents, Stmt: yyDollar[8].compstmt, VarArg: true, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 89:
+	case 135:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser.go.y:658
+//line parser.go.y:990
 		{
-			yyVAL.expr = &ast.FuncExpr{Recv: yyDollar[3].tok.Lit, Name: yyDollar[5].tok.Lit, Params: yyDollar[7].expr_idents, Stmt: yyDollar[11].compstmt, VarArg: true}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Recv: yyDollar[3].tok.Lit, Name: yyDollar[5].tok.Lit, Params: yyDollar[7].expr_idents, Stmt: yyDollar[11].compstmt, VarArg: true, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 90:
+	case 136:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser.go.y:663
+//line parser.go.y:998
 		{
-			yyVAL.expr = &ast.FuncExpr{Recv: yyDollar[3].tok.Lit, Name: yyDollar[5].tok.Lit, Params: yyDollar[7].expr_idents, Stmt: yyDollar[10].compstmt}
+			if !labelsDefined(yylex, yyDollar[1].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Recv: yyDollar[3].tok.Lit, Name: yyDollar[5].tok.Lit, Params: yyDollar[7].expr_idents, Stmt: yyDollar[10].compstmt, Generator: isGenerator(yylex, yyDollar[1].tok)}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 91:
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1006
+		{
+			if !labelsDefined(yylex, yyDollar[2].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Params: []string{yyDollar[1].tok.Lit}, Stmt: yyDollar[3].stmt, Generator: isGenerator(yylex, yyDollar[2].tok)}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 138:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1014
+		{
+			params, ok := lambdaParams(yylex, yyDollar[2].exprs)
+			if !ok || !labelsDefined(yylex, yyDollar[4].tok) {
+				return 1
+			}
+			yyVAL.expr = &ast.FuncExpr{Params: params, Stmt: yyDollar[5].stmt, Generator: isGenerator(yylex, yyDollar[4].tok)}
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 139:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:668
+//line parser.go.y:1023
 		{
 			yyVAL.expr = &ast.ArrayExpr{}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 92:
+	case 140:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:673
+//line parser.go.y:1028
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[3].exprs}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 93:
+	case 141:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:678
+//line parser.go.y:1033
 		{
 			yyVAL.expr = &ast.ArrayExpr{Exprs: yyDollar[5].exprs, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 94:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:683
+//line parser.go.y:1038
 		{
 			yyVAL.expr = &ast.ParenExpr{SubExpr: yyDollar[2].expr}
 			if l, ok := yylex.(*Lexer); ok {
 				yyVAL.expr.SetPosition(l.pos)
 			}
 		}
-	case 95:
+	case 143:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:688
+//line parser.go.y:1043
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 96:
+	case 144:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:693
+//line parser.go.y:1048
 		{
 			yyVAL.expr = &ast.CallErrExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 97:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:698
+//line parser.go.y:1053
 		{
 			yyVAL.expr = &ast.CallExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 98:
+	case 146:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:703
+//line parser.go.y:1058
 		{
 			yyVAL.expr = &ast.CallErrExpr{Name: yyDollar[1].tok.Lit, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 99:
+	case 147:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:708
+//line parser.go.y:1063
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 100:
+	case 148:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:713
+//line parser.go.y:1068
 		{
 			yyVAL.expr = &ast.AnonCallErrExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs, VarArg: true}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 101:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:718
+//line parser.go.y:1073
 		{
 			yyVAL.expr = &ast.AnonCallExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 102:
+	case 150:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:723
+//line parser.go.y:1078
 		{
 			yyVAL.expr = &ast.AnonCallErrExpr{Expr: yyDollar[1].expr, SubExprs: yyDollar[3].exprs}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 103:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:728
+//line parser.go.y:1083
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr_ident, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr_ident.Position())
 		}
-	case 104:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:733
+//line parser.go.y:1088
 		{
 			yyVAL.expr = &ast.ItemExpr{Item: yyDollar[1].expr, Index: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 105:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:738
+//line parser.go.y:1093
 		{
 			yyVAL.expr = &ast.LenExpr{Expr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 106:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:743
+//line parser.go.y:1098
 		{
 			if yyDollar[3].type_data.Kind == ast.TypeDefault {
 				yyDollar[3].type_data.Kind = ast.TypePtr
@@ -2146,93 +2942,150 @@ yydefault:
 			}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 107:
+	case 155:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:753
+//line parser.go.y:1108
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 108:
+	case 156:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:758
+//line parser.go.y:1113
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 109:
+	case 157:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:763
+//line parser.go.y:1118
 		{
 			yyVAL.expr = &ast.MakeExpr{TypeData: yyDollar[3].type_data, LenExpr: yyDollar[5].expr, CapExpr: yyDollar[7].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 110:
+	case 158:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:768
+//line parser.go.y:1123
 		{
 			yyVAL.expr = &ast.MakeTypeExpr{Name: yyDollar[4].tok.Lit, Type: yyDollar[6].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 111:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:773
+//line parser.go.y:1128
 		{
 			yyVAL.expr = &ast.IncludeExpr{ItemExpr: yyDollar[1].expr, ListExpr: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 112:
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1133
+		{
+			yyVAL.expr = &ast.IsExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 161:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1138
+		{
+			yyVAL.expr = &ast.TypeAssertExpr{Expr: yyDollar[1].expr, Type: yyDollar[4].type_data}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 162:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:778
+//line parser.go.y:1143
+		{
+			yyDollar[5].match_cases.Expr = yyDollar[2].expr
+			yyVAL.expr = yyDollar[5].match_cases
+			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 163:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1149
 		{
 			yyDollar[4].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: &ast.TypeStruct{Name: "interface"}, SubType: &ast.TypeStruct{Name: "interface"}}
 			yyVAL.expr = yyDollar[4].expr_map
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 113:
+	case 164:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser.go.y:784
+//line parser.go.y:1155
 		{
 			yyDollar[8].expr_map.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 			yyVAL.expr = yyDollar[8].expr_map
 			yyVAL.expr.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 114:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:790
+//line parser.go.y:1161
 		{
 			yyVAL.expr = yyDollar[3].expr_map
 			yyVAL.expr.SetPosition(yyDollar[3].expr_map.Position())
 		}
-	case 115:
+	case 166:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1166
+		{
+			typeData := structLiteralType(yylex, yyDollar[1].expr)
+			if typeData == nil {
+				return 1
+			}
+			fields := make([]*ast.StructExprField, len(yyDollar[4].exprs))
+			for i, expr := range yyDollar[4].exprs {
+				fields[i] = &ast.StructExprField{Expr: expr}
+				fields[i].SetPosition(expr.Position())
+			}
+			yyVAL.expr = &ast.StructExpr{TypeData: typeData, Fields: fields}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 167:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1180
+		{
+			typeData := structLiteralType(yylex, yyDollar[1].expr)
+			if typeData == nil {
+				return 1
+			}
+			names := make([]string, len(yyDollar[4].struct_fields))
+			for i, field := range yyDollar[4].struct_fields {
+				names[i] = field.Name
+			}
+			if name := duplicateName(names); name != "" {
+				yylex.Error("duplicate field name " + name + " in struct literal")
+				return 1
+			}
+			yyVAL.expr = &ast.StructExpr{TypeData: typeData, Fields: yyDollar[4].struct_fields}
+			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:795
+//line parser.go.y:1197
 		{
 			yyVAL.expr = yyDollar[1].expr_slice
 			yyVAL.expr.SetPosition(yyDollar[1].expr_slice.Position())
 		}
-	case 116:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:800
+//line parser.go.y:1202
 		{
 			yyVAL.expr = yyDollar[1].expr_chan
 			yyVAL.expr.SetPosition(yyDollar[1].expr_chan.Position())
 		}
-	case 120:
+	case 173:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:809
+//line parser.go.y:1211
 		{
 			yyVAL.expr_idents = []string{}
 		}
-	case 121:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:813
+//line parser.go.y:1215
 		{
 			yyVAL.expr_idents = []string{yyDollar[1].tok.Lit}
 		}
-	case 122:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:817
+//line parser.go.y:1219
 		{
 			if len(yyDollar[1].expr_idents) == 0 {
 				yylex.Error("syntax error: unexpected ','")
@@ -2240,15 +3093,21 @@ yydefault:
 			}
 			yyVAL.expr_idents = append(yyDollar[1].expr_idents, yyDollar[4].tok.Lit)
 		}
-	case 123:
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1229
+		{
+			yyVAL.type_data = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
+		}
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:827
+//line parser.go.y:1233
 		{
 			yyVAL.type_data = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
 		}
-	case 124:
+	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:831
+//line parser.go.y:1237
 		{
 			if yyDollar[1].type_data.Kind != ast.TypeDefault {
 				yylex.Error("not type default")
@@ -2257,9 +3116,9 @@ yydefault:
 			yyDollar[1].type_data.Env = append(yyDollar[1].type_data.Env, yyDollar[1].type_data.Name)
 			yyDollar[1].type_data.Name = yyDollar[3].tok.Lit
 		}
-	case 125:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:840
+//line parser.go.y:1246
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypePtr
@@ -2268,9 +3127,9 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypePtr, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 126:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:849
+//line parser.go.y:1255
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeSlice
@@ -2280,15 +3139,15 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeSlice, SubType: yyDollar[2].type_data, Dimensions: yyDollar[1].slice_count}
 			}
 		}
-	case 127:
+	case 181:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:859
+//line parser.go.y:1265
 		{
 			yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeMap, Key: yyDollar[3].type_data, SubType: yyDollar[5].type_data}
 		}
-	case 128:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:863
+//line parser.go.y:1269
 		{
 			if yyDollar[2].type_data.Kind == ast.TypeDefault {
 				yyDollar[2].type_data.Kind = ast.TypeChan
@@ -2297,80 +3156,381 @@ yydefault:
 				yyVAL.type_data = &ast.TypeStruct{Kind: ast.TypeChan, SubType: yyDollar[2].type_data}
 			}
 		}
-	case 129:
+	case 183:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:872
+//line parser.go.y:1278
 		{
 			yyVAL.type_data = yyDollar[4].type_data_struct
 		}
-	case 130:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:878
+	case 184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1284
+		{
+			yyVAL.type_datas = []*ast.TypeStruct{yyDollar[1].type_data}
+		}
+	case 185:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1288
+		{
+			yyVAL.type_datas = append(yyDollar[1].type_datas, yyDollar[4].type_data)
+		}
+	case 186:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1294
 		{
 			yyVAL.type_data_struct = &ast.TypeStruct{
-				Kind:        ast.TypeStructType,
-				StructNames: []string{yyDollar[1].tok.Lit},
-				StructTypes: []*ast.TypeStruct{yyDollar[2].type_data},
-				Name:        yyDollar[2].type_data.Name,
+				Kind:           ast.TypeStructType,
+				StructNames:    []string{yyDollar[1].tok.Lit},
+				StructTypes:    []*ast.TypeStruct{yyDollar[2].type_data},
+				StructEmbedded: []bool{false},
+				StructTags:     []string{yyDollar[3].struct_tag},
+				StructDefaults: []ast.Expr{yyDollar[4].expr},
+				Name:           yyDollar[2].type_data.Name,
 			}
 		}
-	case 131:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.go.y:887
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1306
+		{
+			if isLowerName(yyDollar[1].tok.Lit) {
+				yylex.Error("embedded struct types cannot start with a lowercase letter")
+				return 1
+			}
+			yyVAL.type_data_struct = &ast.TypeStruct{
+				Kind:           ast.TypeStructType,
+				StructNames:    []string{yyDollar[1].tok.Lit},
+				StructTypes:    []*ast.TypeStruct{{Name: yyDollar[1].tok.Lit}},
+				StructEmbedded: []bool{true},
+				StructTags:     []string{""},
+				StructDefaults: []ast.Expr{nil},
+				Name:           yyDollar[1].tok.Lit,
+			}
+		}
+	case 188:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1322
 		{
 			if yyVAL.type_data_struct == nil || len(yyDollar[1].type_data_struct.StructNames) == 0 {
 				yylex.Error("syntax error: expected type declaration")
 				return 1
 			}
-			if yyDollar[3].tok.Lit[0] >= 97 {
+			if isLowerName(yyDollar[3].tok.Lit) {
 				yylex.Error("struct declarations cannot start with a lowercase letter")
 				return 1
 			}
 
 			yyVAL.type_data_struct.StructNames = append(yyVAL.type_data_struct.StructNames, yyDollar[3].tok.Lit)
 			yyVAL.type_data_struct.StructTypes = append(yyVAL.type_data_struct.StructTypes, yyDollar[4].type_data)
+			yyVAL.type_data_struct.StructEmbedded = append(yyVAL.type_data_struct.StructEmbedded, false)
+			yyVAL.type_data_struct.StructTags = append(yyVAL.type_data_struct.StructTags, yyDollar[5].struct_tag)
+			yyVAL.type_data_struct.StructDefaults = append(yyVAL.type_data_struct.StructDefaults, yyDollar[6].expr)
 		}
-	case 132:
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1339
+		{
+			if yyVAL.type_data_struct == nil || len(yyDollar[1].type_data_struct.StructNames) == 0 {
+				yylex.Error("syntax error: expected type declaration")
+				return 1
+			}
+			if isLowerName(yyDollar[3].tok.Lit) {
+				yylex.Error("embedded struct types cannot start with a lowercase letter")
+				return 1
+			}
+
+			yyVAL.type_data_struct.StructNames = append(yyVAL.type_data_struct.StructNames, yyDollar[3].tok.Lit)
+			yyVAL.type_data_struct.StructTypes = append(yyVAL.type_data_struct.StructTypes, &ast.TypeStruct{Name: yyDollar[3].tok.Lit})
+			yyVAL.type_data_struct.StructEmbedded = append(yyVAL.type_data_struct.StructEmbedded, true)
+			yyVAL.type_data_struct.StructTags = append(yyVAL.type_data_struct.StructTags, "")
+			yyVAL.type_data_struct.StructDefaults = append(yyVAL.type_data_struct.StructDefaults, nil)
+		}
+	case 190:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1358
+		{
+			field := &ast.StructExprField{Name: yyDollar[1].tok.Lit, Expr: yyDollar[4].expr}
+			field.SetPosition(yyDollar[1].tok.Position())
+			yyVAL.struct_fields = []*ast.StructExprField{field}
+		}
+	case 191:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.go.y:1364
+		{
+			field := &ast.StructExprField{Name: yyDollar[4].tok.Lit, Expr: yyDollar[7].expr}
+			field.SetPosition(yyDollar[4].tok.Position())
+			yyVAL.struct_fields = append(yyDollar[1].struct_fields, field)
+		}
+	case 192:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1372
+		{
+			yyVAL.struct_tag = ""
+		}
+	case 193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1376
+		{
+			yyVAL.struct_tag = yyDollar[1].tok.Lit
+		}
+	case 194:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1382
+		{
+			yyVAL.expr = nil
+		}
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:903
+//line parser.go.y:1386
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 196:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1392
 		{
 			yyVAL.slice_count = 1
 		}
-	case 133:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:907
+//line parser.go.y:1396
 		{
 			yyVAL.slice_count = yyDollar[3].slice_count + 1
 		}
-	case 134:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:913
+//line parser.go.y:1402
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_member
 		}
-	case 135:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:917
+//line parser.go.y:1406
 		{
 			yyVAL.expr_member_or_ident = yyDollar[1].expr_ident
 		}
-	case 136:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:923
+//line parser.go.y:1412
 		{
 			yyVAL.expr_member = &ast.MemberExpr{Expr: yyDollar[1].expr, Name: yyDollar[3].tok.Lit}
 			yyVAL.expr_member.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 137:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:930
+//line parser.go.y:1419
 		{
 			yyVAL.expr_ident = &ast.IdentExpr{Lit: yyDollar[1].tok.Lit}
 			yyVAL.expr_ident.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 138:
+	case 202:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1426
+		{
+			yyVAL.match_cases = &ast.MatchExpr{}
+		}
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1430
+		{
+			yyDollar[1].match_cases.Cases = append(yyDollar[1].match_cases.Cases, yyDollar[2].match_case)
+			yyVAL.match_cases = yyDollar[1].match_cases
+		}
+	case 204:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1435
+		{
+			if yyDollar[1].match_cases.Default != nil {
+				yylex.Error("multiple default statement")
+				return 1
+			}
+			yyDollar[1].match_cases.Default = yyDollar[5].expr
+			yyVAL.match_cases = yyDollar[1].match_cases
+		}
+	case 205:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1446
+		{
+			yyVAL.match_case = &ast.MatchCase{Patterns: yyDollar[2].patterns, Guard: yyDollar[3].expr, Expr: yyDollar[6].expr}
+			yyVAL.match_case.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 206:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1453
+		{
+			yyVAL.expr = nil
+		}
+	case 207:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1457
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 208:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1463
+		{
+			yyVAL.patterns = []ast.Pattern{yyDollar[1].pattern}
+		}
+	case 209:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1467
+		{
+			yyVAL.patterns = append(yyDollar[1].patterns, yyDollar[4].pattern)
+		}
+	case 210:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1473
+		{
+			yyVAL.pattern = &ast.BindPattern{Name: yyDollar[1].tok.Lit}
+			yyVAL.pattern.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1478
+		{
+			yyVAL.pattern = &ast.TypePattern{Name: yyDollar[1].tok.Lit, Type: yyDollar[3].type_data}
+			yyVAL.pattern.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 212:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1483
+		{
+			yyVAL.pattern = &ast.TypePattern{Type: yyDollar[2].type_data}
+			yyVAL.pattern.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1488
+		{
+			literal, ok := patternLiteral(yylex, yyDollar[1].expr_literals)
+			if !ok {
+				return 1
+			}
+			yyVAL.pattern = &ast.LiteralPattern{Literal: literal}
+			yyVAL.pattern.SetPosition(yyDollar[1].expr_literals.Position())
+		}
+	case 214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1497
+		{
+			from, ok := patternLiteral(yylex, yyDollar[1].expr_literals)
+			if !ok {
+				return 1
+			}
+			to, ok := patternLiteral(yylex, yyDollar[3].expr_literals)
+			if !ok {
+				return 1
+			}
+			if !isRangeBounds(from, to) {
+				yylex.Error("range pattern bounds must be numbers or strings")
+				return 1
+			}
+			yyVAL.pattern = &ast.RangePattern{From: from, To: to}
+			yyVAL.pattern.SetPosition(yyDollar[1].expr_literals.Position())
+		}
+	case 215:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.go.y:1514
+		{
+			yyVAL.pattern = yyDollar[3].pattern
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.pattern.SetPosition(l.pos)
+			}
+		}
+	case 216:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1519
+		{
+			yyVAL.pattern = yyDollar[3].pattern
+			if l, ok := yylex.(*Lexer); ok {
+				yyVAL.pattern.SetPosition(l.pos)
+			}
+		}
+	case 217:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1524
+		{
+			yyDollar[4].pattern.(*ast.StructPattern).Type = &ast.TypeStruct{Name: yyDollar[1].tok.Lit}
+			yyVAL.pattern = yyDollar[4].pattern
+			yyVAL.pattern.SetPosition(yyDollar[1].tok.Position())
+		}
+	case 218:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1532
+		{
+			yyVAL.pattern = &ast.SlicePattern{}
+		}
+	case 219:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1536
+		{
+			yyVAL.pattern = &ast.SlicePattern{Items: yyDollar[1].patterns}
+		}
+	case 220:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1540
+		{
+			yyVAL.pattern = &ast.SlicePattern{HasRest: true, Rest: yyDollar[2].tok.Lit}
+		}
+	case 221:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1544
+		{
+			yyVAL.pattern = &ast.SlicePattern{Items: yyDollar[1].patterns, HasRest: true, Rest: yyDollar[5].tok.Lit}
+		}
+	case 222:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1550
+		{
+			yyVAL.pattern = &ast.MapPattern{}
+		}
+	case 223:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1554
+		{
+			key, ok := patternLiteral(yylex, yyDollar[1].expr_literals)
+			if !ok {
+				return 1
+			}
+			yyVAL.pattern = &ast.MapPattern{Keys: []reflect.Value{key}, Values: []ast.Pattern{yyDollar[3].pattern}}
+		}
+	case 224:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1562
+		{
+			key, ok := patternLiteral(yylex, yyDollar[4].expr_literals)
+			if !ok {
+				return 1
+			}
+			mapPattern := yyDollar[1].pattern.(*ast.MapPattern)
+			mapPattern.Keys = append(mapPattern.Keys, key)
+			mapPattern.Values = append(mapPattern.Values, yyDollar[6].pattern)
+			yyVAL.pattern = mapPattern
+		}
+	case 225:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.go.y:1575
+		{
+			yyVAL.pattern = &ast.StructPattern{}
+		}
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1579
+		{
+			yyVAL.pattern = &ast.StructPattern{Fields: []string{yyDollar[1].tok.Lit}, Values: []ast.Pattern{yyDollar[3].pattern}}
+		}
+	case 227:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.go.y:1583
+		{
+			structPattern := yyDollar[1].pattern.(*ast.StructPattern)
+			structPattern.Fields = append(structPattern.Fields, yyDollar[4].tok.Lit)
+			structPattern.Values = append(structPattern.Values, yyDollar[6].pattern)
+			yyVAL.pattern = structPattern
+		}
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:937
+//line parser.go.y:1592
 		{
 			num, err := toNumber("-" + yyDollar[2].tok.Lit)
 			if err != nil {
@@ -2380,9 +3540,9 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[2].tok.Position())
 		}
-	case 139:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:947
+//line parser.go.y:1602
 		{
 			yyN := yyDollar[1].tok.Lit
 			num, err := toNumber(yyN)
@@ -2393,49 +3553,55 @@ yydefault:
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: num}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 140:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:958
+//line parser.go.y:1613
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: stringToValue(yyDollar[1].tok.Lit)}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 141:
+	case 231:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1618
+		{
+			yyVAL.expr_literals = yyDollar[1].expr
+		}
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:963
+//line parser.go.y:1622
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: trueValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 142:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:968
+//line parser.go.y:1627
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: falseValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 143:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:973
+//line parser.go.y:1632
 		{
 			yyVAL.expr_literals = &ast.LiteralExpr{Literal: nilValue}
 			yyVAL.expr_literals.SetPosition(yyDollar[1].tok.Position())
 		}
-	case 144:
+	case 235:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.go.y:980
+//line parser.go.y:1639
 		{
 			yyVAL.expr_map = &ast.MapExpr{}
 		}
-	case 145:
+	case 236:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:984
+//line parser.go.y:1643
 		{
 			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{yyDollar[1].expr}, Values: []ast.Expr{yyDollar[3].expr}}
 		}
-	case 146:
+	case 237:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:988
+//line parser.go.y:1647
 		{
 			if yyDollar[1].expr_map.Keys == nil {
 				yylex.Error("syntax error: unexpected ','")
@@ -2444,144 +3610,165 @@ yydefault:
 			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, yyDollar[4].expr)
 			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, yyDollar[6].expr)
 		}
-	case 147:
+	case 238:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.go.y:1656
+		{
+			spread := &ast.SpreadExpr{Expr: yyDollar[2].expr}
+			spread.SetPosition(yyDollar[1].tok.Position())
+			yyVAL.expr_map = &ast.MapExpr{Keys: []ast.Expr{spread}, Values: []ast.Expr{nil}}
+		}
+	case 239:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.go.y:1662
+		{
+			if yyDollar[1].expr_map.Keys == nil {
+				yylex.Error("syntax error: unexpected ','")
+				return 1
+			}
+			spread := &ast.SpreadExpr{Expr: yyDollar[5].expr}
+			spread.SetPosition(yyDollar[4].tok.Position())
+			yyVAL.expr_map.Keys = append(yyVAL.expr_map.Keys, spread)
+			yyVAL.expr_map.Values = append(yyVAL.expr_map.Values, nil)
+		}
+	case 240:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:999
+//line parser.go.y:1675
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 148:
+	case 241:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:1003
+//line parser.go.y:1679
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 149:
+	case 242:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:1007
+//line parser.go.y:1683
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 150:
+	case 243:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:1011
+//line parser.go.y:1687
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 151:
+	case 244:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:1015
+//line parser.go.y:1691
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr_ident, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 152:
+	case 245:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.go.y:1019
+//line parser.go.y:1695
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr}
 		}
-	case 153:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:1023
+//line parser.go.y:1699
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: nil}
 		}
-	case 154:
+	case 247:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.go.y:1027
+//line parser.go.y:1703
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: nil, End: yyDollar[4].expr}
 		}
-	case 155:
+	case 248:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.go.y:1031
+//line parser.go.y:1707
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, End: yyDollar[4].expr, Cap: yyDollar[6].expr}
 		}
-	case 156:
+	case 249:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.go.y:1035
+//line parser.go.y:1711
 		{
 			yyVAL.expr_slice = &ast.SliceExpr{Item: yyDollar[1].expr, Begin: yyDollar[3].expr, End: yyDollar[5].expr, Cap: yyDollar[7].expr}
 		}
-	case 157:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1041
+//line parser.go.y:1717
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 158:
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1045
+//line parser.go.y:1721
 		{
 			yyVAL.expr_chan = &ast.ChanExpr{RHS: yyDollar[2].expr}
 		}
-	case 159:
+	case 252:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1051
+//line parser.go.y:1727
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "-", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 160:
+	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1056
+//line parser.go.y:1732
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "!", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 161:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1061
+//line parser.go.y:1737
 		{
 			yyVAL.expr = &ast.UnaryExpr{Operator: "^", Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 162:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1066
+//line parser.go.y:1742
 		{
 			yyVAL.expr = &ast.AddrExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 163:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1071
+//line parser.go.y:1747
 		{
 			yyVAL.expr = &ast.DerefExpr{Expr: yyDollar[2].expr}
 			yyVAL.expr.SetPosition(yyDollar[2].expr.Position())
 		}
-	case 164:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:1078
+//line parser.go.y:1754
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 165:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:1083
+//line parser.go.y:1759
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 166:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:1088
+//line parser.go.y:1764
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 167:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.go.y:1093
+//line parser.go.y:1769
 		{
 			yyVAL.expr = &ast.OpExpr{Op: yyDollar[1].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 168:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1100
+//line parser.go.y:1776
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2589,9 +3776,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 169:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.go.y:1108
+//line parser.go.y:1784
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: oneLiteral}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2599,9 +3786,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 170:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1116
+//line parser.go.y:1792
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2609,9 +3796,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 171:
+	case 264:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1124
+//line parser.go.y:1800
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2619,9 +3806,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 172:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1132
+//line parser.go.y:1808
 		{
 			rhs := &ast.OpExpr{Op: &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2629,9 +3816,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 173:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1140
+//line parser.go.y:1816
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2639,9 +3826,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 174:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1148
+//line parser.go.y:1824
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2649,9 +3836,9 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 175:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1156
+//line parser.go.y:1832
 		{
 			rhs := &ast.OpExpr{Op: &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}}
 			rhs.Op.SetPosition(yyDollar[1].expr.Position())
@@ -2659,125 +3846,138 @@ yydefault:
 			yyVAL.expr = &ast.LetsExpr{LHSS: []ast.Expr{yyDollar[1].expr}, RHSS: []ast.Expr{rhs}}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 176:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1167
+//line parser.go.y:1843
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "*", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 177:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1172
+//line parser.go.y:1848
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "/", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 178:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1177
+//line parser.go.y:1853
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "%", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 179:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1182
+//line parser.go.y:1858
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "<<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 180:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1187
+//line parser.go.y:1863
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: ">>", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 181:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1192
+//line parser.go.y:1868
 		{
 			yyVAL.expr = &ast.MultiplyOperator{LHS: yyDollar[1].expr, Operator: "&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 182:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1199
+//line parser.go.y:1875
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "+", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 183:
+	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1204
+//line parser.go.y:1880
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "-", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 184:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1209
+//line parser.go.y:1885
 		{
 			yyVAL.expr = &ast.AddOperator{LHS: yyDollar[1].expr, Operator: "|", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 185:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1216
+//line parser.go.y:1892
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "==", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 186:
+	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1221
+//line parser.go.y:1897
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "!=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 187:
+	case 280:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1226
+//line parser.go.y:1902
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 188:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1231
+//line parser.go.y:1907
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: "<=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 189:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1236
+//line parser.go.y:1912
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 190:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1241
+//line parser.go.y:1917
 		{
 			yyVAL.expr = &ast.ComparisonOperator{LHS: yyDollar[1].expr, Operator: ">=", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 191:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1248
+//line parser.go.y:1924
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "&&", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
-	case 192:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.go.y:1253
+//line parser.go.y:1929
 		{
 			yyVAL.expr = &ast.BinaryOperator{LHS: yyDollar[1].expr, Operator: "||", RHS: yyDollar[3].expr}
 			yyVAL.expr.SetPosition(yyDollar[1].expr.Position())
 		}
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.go.y:1946
+		{
+			yyVAL.stmt = &ast.ReturnStmt{Exprs: []ast.Expr{yyDollar[1].expr}}
+			yyVAL.stmt.SetPosition(yyDollar[1].expr.Position())
+		}
+	case 292:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.go.y:1951
+		{
+			yyVAL.stmt = yyDollar[2].compstmt
+		}
 	}
 	goto yystack /* stack new state and value */
 }
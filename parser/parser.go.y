@@ -2,6 +2,8 @@
 package parser
 
 import (
+	"reflect"
+
 	"github.com/dgrr/pako/ast"
 )
 
@@ -16,6 +18,11 @@ import (
 %type<stmt_import> stmt_import
 %type<stmt_module> stmt_module
 %type<stmt_struct> stmt_struct
+%type<stmt_interface> stmt_interface
+%type<stmt> stmt_const
+%type<stmt> stmt_enum
+%type<interface_methods> interface_methods
+%type<interface_method> interface_method
 %type<stmt_var> stmt_var
 %type<stmt_lets> stmt_lets
 %type<stmt_if> stmt_if
@@ -24,13 +31,25 @@ import (
 %type<stmt_switch_cases> stmt_switch_cases
 %type<stmt_switch_case> stmt_switch_case
 %type<stmt_switch_default> stmt_switch_default
+%type<stmt_select> stmt_select
+%type<stmt_select_cases> stmt_select_cases
+%type<stmt_select_case> stmt_select_case
+%type<stmt_type_switch_cases> stmt_type_switch_cases
+%type<stmt_type_switch_case> stmt_type_switch_case
 
 %type<exprs> exprs
+%type<exprs> array_items
+%type<expr> array_item
 %type<expr> expr
 %type<expr_idents> expr_idents
 %type<type_data> type_data
 %type<type_data_struct> type_data_struct
+%type<type_datas> type_datas
 %type<slice_count> slice_count
+%type<struct_tag> opt_struct_tag
+%type<struct_fields> struct_fields
+%type<expr> opt_struct_default
+%type<stmt> lambda_body
 %type<expr_member_or_ident> expr_member_or_ident
 %type<expr_member> expr_member
 %type<expr_ident> expr_ident
@@ -42,6 +61,15 @@ import (
 %type<expr> expr_binary
 %type<expr> expr_lets
 
+%type<match_cases> match_cases
+%type<match_case> match_case
+%type<expr> match_guard
+%type<patterns> patterns
+%type<pattern> pattern
+%type<pattern> pattern_slice
+%type<pattern> pattern_map
+%type<pattern> pattern_struct
+
 %type<expr> op_binary
 %type<expr> op_comparison
 %type<expr> op_add
@@ -59,6 +87,9 @@ import (
 	stmt_import             ast.Stmt
 	stmt_module             ast.Stmt
 	stmt_struct             ast.Stmt
+	stmt_interface          ast.Stmt
+	interface_methods       []*ast.InterfaceMethod
+	interface_method        *ast.InterfaceMethod
 	stmt_var                ast.Stmt
 	stmt_lets               ast.Stmt
 	stmt_if                 ast.Stmt
@@ -67,13 +98,21 @@ import (
 	stmt_switch_cases       ast.Stmt
 	stmt_switch_case        ast.Stmt
 	stmt_switch_default     ast.Stmt
+	stmt_select             ast.Stmt
+	stmt_select_cases       ast.Stmt
+	stmt_select_case        ast.Stmt
+	stmt_type_switch_cases  ast.Stmt
+	stmt_type_switch_case   ast.Stmt
 
 	exprs                   []ast.Expr
 	expr                    ast.Expr
 	expr_idents             []string
 	type_data               *ast.TypeStruct
 	type_data_struct        *ast.TypeStruct
+	type_datas              []*ast.TypeStruct
 	slice_count             int
+	struct_tag              string
+	struct_fields           []*ast.StructExprField
 	expr_member_or_ident    ast.Expr
 	expr_member             *ast.MemberExpr
 	expr_ident              *ast.IdentExpr
@@ -85,28 +124,37 @@ import (
 	expr_binary             ast.Expr
 	expr_lets               ast.Expr
 
+	match_cases             *ast.MatchExpr
+	match_case              *ast.MatchCase
+	patterns                []ast.Pattern
+	pattern                 ast.Pattern
+
 	op_binary               ast.Operator
 	op_comparison           ast.Operator
 	op_add                  ast.Operator
 	op_multiply             ast.Operator
 }
 
-%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH CASE DEFAULT GO CHAN STRUCT MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT AS
+%token<tok> IDENT NUMBER STRING ARRAY VARARG FUNC RETURN VAR THROW YIELD IF ELSE FOR IN EQEQ NEQ GE LE OROR ANDAND NEW TRUE FALSE NIL NILCOALESCE MODULE TRY CATCH FINALLY PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ BREAK CONTINUE PLUSPLUS MINUSMINUS SHIFTLEFT SHIFTRIGHT SWITCH SELECT CASE DEFAULT GO DEFER CHAN STRUCT INTERFACE IS MAKE OPCHAN EQOPCHAN TYPE LEN DELETE CLOSE MAP IMPORT AS MATCH RANGE CONST ENUM LITBRACE ARROW ARROWPAREN ARROWBRACE
+%token<expr> FSTRING
+%token<tok> '('
 
 /* lowest precedence */
 %left ,
+%right ARROW
 %right '=' PLUSEQ MINUSEQ MULEQ DIVEQ ANDEQ OREQ EQOPCHAN
 %right ':'
 %right OPCHAN
 %right '?' NILCOALESCE
 %left OROR
 %left ANDAND
-%left EQEQ NEQ '<' LE '>' GE
+%left EQEQ NEQ '<' LE '>' GE IS
 %left '+' '-' '|' '^'
 %left '*' '/' '%' SHIFTLEFT SHIFTRIGHT '&'
 %right IN
 %right PLUSPLUS MINUSMINUS
 %right UNARY
+%left LITBRACE '(' '.' '['
 /* highest precedence */
 /* https://golang.org/ref/spec#Expression */
 
@@ -194,6 +242,18 @@ modstmt :
 	{
 		$$ = $1
 	}
+	| stmt_interface
+	{
+		$$ = $1
+	}
+	| stmt_const
+	{
+		$$ = $1
+	}
+	| stmt_enum
+	{
+		$$ = $1
+	}
 	| stmt_import
 	{
 		$$ = $1
@@ -219,6 +279,39 @@ stmt :
 		$$ = &ast.ContinueStmt{}
 		$$.SetPosition($1.Position())
 	}
+	| BREAK IDENT
+	{
+		$$ = &ast.BreakStmt{Label: $2.Lit}
+		$$.SetPosition($1.Position())
+		addLabelRef(yylex, $1, $2.Lit, false)
+	}
+	| CONTINUE IDENT
+	{
+		$$ = &ast.ContinueStmt{Label: $2.Lit}
+		$$.SetPosition($1.Position())
+		addLabelRef(yylex, $1, $2.Lit, true)
+	}
+	| IDENT ':' opt_newlines stmt_for
+	{
+		if !setLabel(yylex, $1, $4) {
+			return 1
+		}
+		$$ = $4
+	}
+	| IDENT ':' opt_newlines stmt_switch
+	{
+		if !setLabel(yylex, $1, $4) {
+			return 1
+		}
+		$$ = $4
+	}
+	| IDENT ':' opt_newlines stmt_select
+	{
+		if !setLabel(yylex, $1, $4) {
+			return 1
+		}
+		$$ = $4
+	}
 	| RETURN exprs
 	{
 		$$ = &ast.ReturnStmt{Exprs: $2}
@@ -229,11 +322,17 @@ stmt :
 		$$ = &ast.ThrowStmt{Expr: $2}
 		$$.SetPosition($1.Position())
 	}
+	| YIELD expr
+	{
+		$$ = &ast.YieldStmt{Expr: $2}
+		$$.SetPosition($1.Position())
+		if l, ok := yylex.(*Lexer); ok { l.yields = append(l.yields, $1.Position()) }
+	}
 	| stmt_module
 	{
 		$$ = $1
 	}
-	| TRY '{' compstmt '}' CATCH IDENT '{' compstmt '}' FINALLY '{' compstmt '}'
+	| TRY '{' compstmt '}' CATCH IDENT lbrace compstmt '}' FINALLY '{' compstmt '}'
 	{
 		$$ = &ast.TryStmt{Try: $3, Var: $6.Lit, Catch: $8, Finally: $12}
 		$$.SetPosition($1.Position())
@@ -243,7 +342,7 @@ stmt :
 		$$ = &ast.TryStmt{Try: $3, Catch: $7, Finally: $11}
 		$$.SetPosition($1.Position())
 	}
-	| TRY '{' compstmt '}' CATCH IDENT '{' compstmt '}'
+	| TRY '{' compstmt '}' CATCH IDENT lbrace compstmt '}'
 	{
 		$$ = &ast.TryStmt{Try: $3, Var: $6.Lit, Catch: $8}
 		$$.SetPosition($1.Position())
@@ -273,6 +372,26 @@ stmt :
 		$$ = &ast.GoroutineStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: $4, Go: true}}
 		$$.SetPosition($1.Position())
 	}
+	| DEFER IDENT '(' exprs VARARG ')'
+	{
+		$$ = &ast.DeferStmt{Expr: &ast.CallExpr{Name: $2.Lit, SubExprs: $4, VarArg: true, Defer: true}}
+		$$.SetPosition($1.Position())
+	}
+	| DEFER IDENT '(' exprs ')'
+	{
+		$$ = &ast.DeferStmt{Expr: &ast.CallExpr{Name: $2.Lit, SubExprs: $4, Defer: true}}
+		$$.SetPosition($1.Position())
+	}
+	| DEFER expr '(' exprs VARARG ')'
+	{
+		$$ = &ast.DeferStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: $4, VarArg: true, Defer: true}}
+		$$.SetPosition($1.Position())
+	}
+	| DEFER expr '(' exprs ')'
+	{
+		$$ = &ast.DeferStmt{Expr: &ast.AnonCallExpr{Expr: $2, SubExprs: $4, Defer: true}}
+		$$.SetPosition($1.Position())
+	}
 	| DELETE '(' expr ')'
 	{
 		$$ = &ast.DeleteStmt{Item: $3}
@@ -300,6 +419,10 @@ stmt :
 	{
 		$$ = $1
 	}
+	| stmt_select
+	{
+		$$ = $1
+	}
 	| stmt_import
 	{
 		$$ = $1
@@ -308,6 +431,18 @@ stmt :
 	{
 		$$ = $1
 	}
+	| stmt_interface
+	{
+		$$ = $1
+	}
+	| stmt_const
+	{
+		$$ = $1
+	}
+	| stmt_enum
+	{
+		$$ = $1
+	}
 	| expr
 	{
 		$$ = &ast.ExprStmt{Expr: $1}
@@ -320,7 +455,7 @@ stmt_module :
 		yylex.Error("can't create anonymous module")
 		return 1
 	}
-	| MODULE IDENT '{' modstmts '}'
+	| MODULE IDENT lbrace modstmts '}'
 	{
 		$$ = &ast.ModuleStmt{Name: $2.Lit, Stmt: $4}
 		$$.SetPosition($1.Position())
@@ -524,7 +659,7 @@ stmt_for :
 	}
 
 stmt_struct :
-	STRUCT IDENT '{' newlines type_data_struct newlines '}'
+	STRUCT IDENT lbrace newlines type_data_struct newlines '}'
 	{
 		$$ = &ast.StructStmt{
 			Name: $2.Lit,
@@ -532,6 +667,56 @@ stmt_struct :
 		}
 	}
 
+stmt_interface :
+	INTERFACE IDENT lbrace opt_newlines '}'
+	{
+		$$ = &ast.InterfaceStmt{Name: $2.Lit}
+		$$.SetPosition($1.Position())
+	}
+	| INTERFACE IDENT lbrace opt_newlines interface_methods opt_newlines '}'
+	{
+		$$ = &ast.InterfaceStmt{Name: $2.Lit, Methods: $5}
+		$$.SetPosition($1.Position())
+	}
+
+stmt_const :
+	CONST IDENT '=' expr
+	{
+		$$ = &ast.ConstStmt{Name: $2.Lit, Expr: $4}
+		$$.SetPosition($1.Position())
+	}
+
+stmt_enum :
+	ENUM IDENT lbrace opt_newlines expr_idents opt_comma_newlines '}'
+	{
+		if member := duplicateName($5); member != "" {
+			yylex.Error("duplicate enum member " + member)
+			return 1
+		}
+		$$ = &ast.EnumStmt{Name: $2.Lit, Members: $5}
+		$$.SetPosition($1.Position())
+	}
+
+interface_methods :
+	interface_method
+	{
+		$$ = []*ast.InterfaceMethod{$1}
+	}
+	| interface_methods newlines interface_method
+	{
+		$$ = append($1, $3)
+	}
+
+interface_method :
+	IDENT '(' expr_idents ')'
+	{
+		$$ = &ast.InterfaceMethod{Name: $1.Lit, Params: $3}
+	}
+	| IDENT '(' expr_idents VARARG ')'
+	{
+		$$ = &ast.InterfaceMethod{Name: $1.Lit, Params: $3, VarArg: true}
+	}
+
 stmt_switch :
 	SWITCH expr '{' opt_newlines stmt_switch_cases opt_newlines '}'
 	{
@@ -540,6 +725,49 @@ stmt_switch :
 		$$ = switchStmt
 		$$.SetPosition($1.Position())
 	}
+	| SWITCH expr '.' '(' TYPE ')' '{' opt_newlines stmt_type_switch_cases opt_newlines '}'
+	{
+		switchStmt := $9.(*ast.TypeSwitchStmt)
+		switchStmt.Expr = $2
+		$$ = switchStmt
+		$$.SetPosition($1.Position())
+	}
+
+stmt_type_switch_cases :
+	/* nothing */
+	{
+		$$ = &ast.TypeSwitchStmt{}
+	}
+	| stmt_switch_default
+	{
+		$$ = &ast.TypeSwitchStmt{Default: $1}
+	}
+	| stmt_type_switch_case
+	{
+		$$ = &ast.TypeSwitchStmt{Cases: []ast.Stmt{$1}}
+	}
+	| stmt_type_switch_cases stmt_type_switch_case
+	{
+		switchStmt := $1.(*ast.TypeSwitchStmt)
+		switchStmt.Cases = append(switchStmt.Cases, $2)
+		$$ = switchStmt
+	}
+	| stmt_type_switch_cases stmt_switch_default
+	{
+		switchStmt := $1.(*ast.TypeSwitchStmt)
+		if switchStmt.Default != nil {
+			yylex.Error("multiple default statement")
+			return 1
+		}
+		switchStmt.Default = $2
+	}
+
+stmt_type_switch_case :
+	CASE type_datas ':' compstmt
+	{
+		$$ = &ast.TypeSwitchCaseStmt{Types: $2, Stmt: $4}
+		$$.SetPosition($1.Position())
+	}
 
 stmt_switch_cases :
 	/* nothing */
@@ -582,6 +810,72 @@ stmt_switch_case :
 		$$.SetPosition($1.Position())
 	}
 
+stmt_select :
+	SELECT '{' opt_newlines stmt_select_cases opt_newlines '}'
+	{
+		$$ = $4
+		$$.SetPosition($1.Position())
+	}
+
+stmt_select_cases :
+	/* nothing */
+	{
+		$$ = &ast.SelectStmt{}
+	}
+	| stmt_switch_default
+	{
+		$$ = &ast.SelectStmt{Default: $1}
+	}
+	| stmt_select_case
+	{
+		$$ = &ast.SelectStmt{Cases: []ast.Stmt{$1}}
+	}
+	| stmt_select_cases stmt_select_case
+	{
+		selectStmt := $1.(*ast.SelectStmt)
+		selectStmt.Cases = append(selectStmt.Cases, $2)
+		$$ = selectStmt
+	}
+	| stmt_select_cases stmt_switch_default
+	{
+		selectStmt := $1.(*ast.SelectStmt)
+		if selectStmt.Default != nil {
+			yylex.Error("multiple default statement")
+			return 1
+		}
+		selectStmt.Default = $2
+	}
+
+stmt_select_case :
+	CASE expr ':' compstmt
+	{
+		chanExpr, ok := $2.(*ast.ChanExpr)
+		if !ok {
+			yylex.Error("select case must be receive or send")
+			return 1
+		}
+		if chanExpr.LHS == nil {
+			$$ = &ast.SelectCaseStmt{Chan: chanExpr.RHS, Stmt: $4}
+		} else {
+			$$ = &ast.SelectCaseStmt{Chan: chanExpr.LHS, Send: chanExpr.RHS, Stmt: $4}
+		}
+		$$.SetPosition($1.Position())
+	}
+	| CASE expr EQOPCHAN expr ':' compstmt
+	{
+		$$ = &ast.SelectCaseStmt{Chan: $4, LHS: $2, Stmt: $6}
+		$$.SetPosition($1.Position())
+	}
+	| CASE exprs EQOPCHAN expr ':' compstmt
+	{
+		if len($2) != 2 {
+			yylex.Error("select case must be receive or send")
+			return 1
+		}
+		$$ = &ast.SelectCaseStmt{Chan: $4, LHS: $2[0], OkExpr: $2[1], Stmt: $6}
+		$$.SetPosition($1.Position())
+	}
+
 stmt_switch_default :
 	DEFAULT ':' compstmt
 	{
@@ -615,6 +909,32 @@ exprs :
 		$$ = append($1, $4)
 	}
 
+array_items :
+	/* nothing */
+	{
+		$$ = nil
+	}
+	| array_item
+	{
+		$$ = []ast.Expr{$1}
+	}
+	| array_items ',' opt_newlines array_item
+	{
+		if len($1) == 0 {
+			yylex.Error("syntax error: unexpected ','")
+			return 1
+		}
+		$$ = append($1, $4)
+	}
+
+array_item :
+	expr
+	| VARARG expr
+	{
+		$$ = &ast.SpreadExpr{Expr: $2}
+		$$.SetPosition($1.Position())
+	}
+
 expr :
 	expr_member_or_ident
 	{
@@ -636,32 +956,67 @@ expr :
 	}
 	| FUNC '(' expr_idents ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Params: $3, Stmt: $6}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Params: $3, Stmt: $6, Generator: isGenerator(yylex, $1)}
 		$$.SetPosition($1.Position())
 	}
 	| FUNC '(' expr_idents VARARG ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Params: $3, Stmt: $7, VarArg: true}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Params: $3, Stmt: $7, VarArg: true, Generator: isGenerator(yylex, $1)}
 		$$.SetPosition($1.Position())
 	}
 	| FUNC IDENT '(' expr_idents ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $7}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $7, Generator: isGenerator(yylex, $1)}
 		$$.SetPosition($1.Position())
 	}
 	| FUNC IDENT '(' expr_idents VARARG ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $8, VarArg: true}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Name: $2.Lit, Params: $4, Stmt: $8, VarArg: true, Generator: isGenerator(yylex, $1)}
 		$$.SetPosition($1.Position())
 	}
 	| FUNC '|' IDENT '|' IDENT '(' expr_idents VARARG ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Recv: $3.Lit, Name: $5.Lit, Params: $7, Stmt: $11, VarArg: true}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Recv: $3.Lit, Name: $5.Lit, Params: $7, Stmt: $11, VarArg: true, Generator: isGenerator(yylex, $1)}
 		$$.SetPosition($1.Position())
 	}
 	| FUNC '|' IDENT '|' IDENT '(' expr_idents ')' '{' compstmt '}'
 	{
-		$$ = &ast.FuncExpr{Recv: $3.Lit, Name: $5.Lit, Params: $7, Stmt: $10}
+		if !labelsDefined(yylex, $1) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Recv: $3.Lit, Name: $5.Lit, Params: $7, Stmt: $10, Generator: isGenerator(yylex, $1)}
+		$$.SetPosition($1.Position())
+	}
+	| IDENT ARROW lambda_body
+	{
+		if !labelsDefined(yylex, $2) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Params: []string{$1.Lit}, Stmt: $3, Generator: isGenerator(yylex, $2)}
+		$$.SetPosition($1.Position())
+	}
+	| ARROWPAREN exprs ')' ARROW lambda_body
+	{
+		params, ok := lambdaParams(yylex, $2)
+		if !ok || !labelsDefined(yylex, $4) {
+			return 1
+		}
+		$$ = &ast.FuncExpr{Params: params, Stmt: $5, Generator: isGenerator(yylex, $4)}
 		$$.SetPosition($1.Position())
 	}
 	| '[' ']'
@@ -669,12 +1024,12 @@ expr :
 		$$ = &ast.ArrayExpr{}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
-	| '[' opt_newlines exprs opt_comma_newlines ']'
+	| '[' opt_newlines array_items opt_comma_newlines ']'
 	{
 		$$ = &ast.ArrayExpr{Exprs: $3}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
 	}
-	| slice_count type_data '{' opt_newlines exprs opt_comma_newlines '}'
+	| slice_count type_data lbrace opt_newlines array_items opt_comma_newlines '}'
 	{
 		$$ = &ast.ArrayExpr{Exprs: $5, TypeData: &ast.TypeStruct{Kind: ast.TypeSlice, SubType: $2, Dimensions: $1}}
 		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
@@ -774,13 +1129,29 @@ expr :
 		$$ = &ast.IncludeExpr{ItemExpr: $1, ListExpr: $3}
 		$$.SetPosition($1.Position())
 	}
+	| expr IS type_data
+	{
+		$$ = &ast.IsExpr{Expr: $1, Type: $3}
+		$$.SetPosition($1.Position())
+	}
+	| expr '.' '(' type_data ')'
+	{
+		$$ = &ast.TypeAssertExpr{Expr: $1, Type: $4}
+		$$.SetPosition($1.Position())
+	}
+	| MATCH expr '{' opt_newlines match_cases '}'
+	{
+		$5.Expr = $2
+		$$ = $5
+		$$.SetPosition($1.Position())
+	}
 	| MAP '{' opt_newlines expr_map opt_comma_newlines '}'
 	{
 		$4.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: &ast.TypeStruct{Name: "interface"}, SubType: &ast.TypeStruct{Name: "interface"}}
 		$$ = $4
 		$$.SetPosition($1.Position())
 	}
-	| MAP '[' type_data ']' type_data '{' opt_newlines expr_map opt_comma_newlines '}'
+	| MAP '[' type_data ']' type_data lbrace opt_newlines expr_map opt_comma_newlines '}'
 	{
 		$8.TypeData = &ast.TypeStruct{Kind: ast.TypeMap, Key: $3, SubType: $5}
 		$$ = $8
@@ -791,6 +1162,37 @@ expr :
 		$$ = $3
 		$$.SetPosition($3.Position())
 	}
+	| expr LITBRACE opt_newlines exprs opt_comma_newlines '}'
+	{
+		typeData := structLiteralType(yylex, $1)
+		if typeData == nil {
+			return 1
+		}
+		fields := make([]*ast.StructExprField, len($4))
+		for i, expr := range $4 {
+			fields[i] = &ast.StructExprField{Expr: expr}
+			fields[i].SetPosition(expr.Position())
+		}
+		$$ = &ast.StructExpr{TypeData: typeData, Fields: fields}
+		$$.SetPosition($1.Position())
+	}
+	| expr LITBRACE opt_newlines struct_fields opt_comma_newlines '}'
+	{
+		typeData := structLiteralType(yylex, $1)
+		if typeData == nil {
+			return 1
+		}
+		names := make([]string, len($4))
+		for i, field := range $4 {
+			names[i] = field.Name
+		}
+		if name := duplicateName(names); name != "" {
+			yylex.Error("duplicate field name " + name + " in struct literal")
+			return 1
+		}
+		$$ = &ast.StructExpr{TypeData: typeData, Fields: $4}
+		$$.SetPosition($1.Position())
+	}
 	| expr_slice
 	{
 		$$ = $1
@@ -827,6 +1229,10 @@ type_data :
 	{
 		$$ = &ast.TypeStruct{Name: $1.Lit}
 	}
+	| INTERFACE
+	{
+		$$ = &ast.TypeStruct{Name: $1.Lit}
+	}
 	| type_data '.' IDENT
 	{
 		if $1.Kind != ast.TypeDefault {
@@ -873,29 +1279,112 @@ type_data :
 		$$ = $4
 	}
 
+type_datas :
+	type_data
+	{
+		$$ = []*ast.TypeStruct{$1}
+	}
+	| type_datas ',' opt_newlines type_data
+	{
+		$$ = append($1, $4)
+	}
+
 type_data_struct :
-	IDENT type_data
+	IDENT type_data opt_struct_tag opt_struct_default
 	{
 		$$ = &ast.TypeStruct{
 			Kind: ast.TypeStructType,
 			StructNames: []string{$1.Lit},
 			StructTypes: []*ast.TypeStruct{$2},
+			StructEmbedded: []bool{false},
+			StructTags: []string{$3},
+			StructDefaults: []ast.Expr{$4},
 			Name: $2.Name,
 		}
 	}
-	| type_data_struct comma_newlines IDENT type_data
+	| IDENT
+	{
+		if isLowerName($1.Lit) {
+			yylex.Error("embedded struct types cannot start with a lowercase letter")
+			return 1
+		}
+		$$ = &ast.TypeStruct{
+			Kind: ast.TypeStructType,
+			StructNames: []string{$1.Lit},
+			StructTypes: []*ast.TypeStruct{&ast.TypeStruct{Name: $1.Lit}},
+			StructEmbedded: []bool{true},
+			StructTags: []string{""},
+			StructDefaults: []ast.Expr{nil},
+			Name: $1.Lit,
+		}
+	}
+	| type_data_struct comma_newlines IDENT type_data opt_struct_tag opt_struct_default
 	{
 		if $$ == nil || len($1.StructNames) == 0 {
 			yylex.Error("syntax error: expected type declaration")
 			return 1
 		}
-		if $3.Lit[0] >= 97 {
+		if isLowerName($3.Lit) {
 			yylex.Error("struct declarations cannot start with a lowercase letter")
 			return 1
 		}
 
 		$$.StructNames = append($$.StructNames, $3.Lit)
 		$$.StructTypes = append($$.StructTypes, $4)
+		$$.StructEmbedded = append($$.StructEmbedded, false)
+		$$.StructTags = append($$.StructTags, $5)
+		$$.StructDefaults = append($$.StructDefaults, $6)
+	}
+	| type_data_struct comma_newlines IDENT
+	{
+		if $$ == nil || len($1.StructNames) == 0 {
+			yylex.Error("syntax error: expected type declaration")
+			return 1
+		}
+		if isLowerName($3.Lit) {
+			yylex.Error("embedded struct types cannot start with a lowercase letter")
+			return 1
+		}
+
+		$$.StructNames = append($$.StructNames, $3.Lit)
+		$$.StructTypes = append($$.StructTypes, &ast.TypeStruct{Name: $3.Lit})
+		$$.StructEmbedded = append($$.StructEmbedded, true)
+		$$.StructTags = append($$.StructTags, "")
+		$$.StructDefaults = append($$.StructDefaults, nil)
+	}
+
+struct_fields :
+	IDENT ':' opt_newlines expr
+	{
+		field := &ast.StructExprField{Name: $1.Lit, Expr: $4}
+		field.SetPosition($1.Position())
+		$$ = []*ast.StructExprField{field}
+	}
+	| struct_fields ',' opt_newlines IDENT ':' opt_newlines expr
+	{
+		field := &ast.StructExprField{Name: $4.Lit, Expr: $7}
+		field.SetPosition($4.Position())
+		$$ = append($1, field)
+	}
+
+opt_struct_tag :
+	/* nothing */
+	{
+		$$ = ""
+	}
+	| STRING
+	{
+		$$ = $1.Lit
+	}
+
+opt_struct_default :
+	/* nothing */
+	{
+		$$ = nil
+	}
+	| '=' expr
+	{
+		$$ = $2
 	}
 
 slice_count :
@@ -932,6 +1421,172 @@ expr_ident :
 		$$.SetPosition($1.Position())
 	}
 
+match_cases :
+	/* nothing */
+	{
+		$$ = &ast.MatchExpr{}
+	}
+	| match_cases match_case opt_term
+	{
+		$1.Cases = append($1.Cases, $2)
+		$$ = $1
+	}
+	| match_cases DEFAULT ':' opt_newlines expr opt_term
+	{
+		if $1.Default != nil {
+			yylex.Error("multiple default statement")
+			return 1
+		}
+		$1.Default = $5
+		$$ = $1
+	}
+
+match_case :
+	CASE patterns match_guard ':' opt_newlines expr
+	{
+		$$ = &ast.MatchCase{Patterns: $2, Guard: $3, Expr: $6}
+		$$.SetPosition($1.Position())
+	}
+
+match_guard :
+	/* nothing */
+	{
+		$$ = nil
+	}
+	| IF expr
+	{
+		$$ = $2
+	}
+
+patterns :
+	pattern
+	{
+		$$ = []ast.Pattern{$1}
+	}
+	| patterns ',' opt_newlines pattern
+	{
+		$$ = append($1, $4)
+	}
+
+pattern :
+	IDENT
+	{
+		$$ = &ast.BindPattern{Name: $1.Lit}
+		$$.SetPosition($1.Position())
+	}
+	| IDENT IS type_data
+	{
+		$$ = &ast.TypePattern{Name: $1.Lit, Type: $3}
+		$$.SetPosition($1.Position())
+	}
+	| IS type_data
+	{
+		$$ = &ast.TypePattern{Type: $2}
+		$$.SetPosition($1.Position())
+	}
+	| expr_literals
+	{
+		literal, ok := patternLiteral(yylex, $1)
+		if !ok {
+			return 1
+		}
+		$$ = &ast.LiteralPattern{Literal: literal}
+		$$.SetPosition($1.Position())
+	}
+	| expr_literals RANGE expr_literals
+	{
+		from, ok := patternLiteral(yylex, $1)
+		if !ok {
+			return 1
+		}
+		to, ok := patternLiteral(yylex, $3)
+		if !ok {
+			return 1
+		}
+		if !isRangeBounds(from, to) {
+			yylex.Error("range pattern bounds must be numbers or strings")
+			return 1
+		}
+		$$ = &ast.RangePattern{From: from, To: to}
+		$$.SetPosition($1.Position())
+	}
+	| '[' opt_newlines pattern_slice ']'
+	{
+		$$ = $3
+		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
+	}
+	| '{' opt_newlines pattern_map opt_comma_newlines '}'
+	{
+		$$ = $3
+		if l, ok := yylex.(*Lexer); ok { $$.SetPosition(l.pos) }
+	}
+	| IDENT lbrace opt_newlines pattern_struct opt_comma_newlines '}'
+	{
+		$4.(*ast.StructPattern).Type = &ast.TypeStruct{Name: $1.Lit}
+		$$ = $4
+		$$.SetPosition($1.Position())
+	}
+
+pattern_slice :
+	/* nothing */
+	{
+		$$ = &ast.SlicePattern{}
+	}
+	| patterns opt_comma_newlines
+	{
+		$$ = &ast.SlicePattern{Items: $1}
+	}
+	| VARARG IDENT opt_newlines
+	{
+		$$ = &ast.SlicePattern{HasRest: true, Rest: $2.Lit}
+	}
+	| patterns ',' opt_newlines VARARG IDENT opt_newlines
+	{
+		$$ = &ast.SlicePattern{Items: $1, HasRest: true, Rest: $5.Lit}
+	}
+
+pattern_map :
+	/* nothing */
+	{
+		$$ = &ast.MapPattern{}
+	}
+	| expr_literals ':' pattern
+	{
+		key, ok := patternLiteral(yylex, $1)
+		if !ok {
+			return 1
+		}
+		$$ = &ast.MapPattern{Keys: []reflect.Value{key}, Values: []ast.Pattern{$3}}
+	}
+	| pattern_map ',' opt_newlines expr_literals ':' pattern
+	{
+		key, ok := patternLiteral(yylex, $4)
+		if !ok {
+			return 1
+		}
+		mapPattern := $1.(*ast.MapPattern)
+		mapPattern.Keys = append(mapPattern.Keys, key)
+		mapPattern.Values = append(mapPattern.Values, $6)
+		$$ = mapPattern
+	}
+
+pattern_struct :
+	/* nothing */
+	{
+		$$ = &ast.StructPattern{}
+	}
+	| IDENT ':' pattern
+	{
+		$$ = &ast.StructPattern{Fields: []string{$1.Lit}, Values: []ast.Pattern{$3}}
+	}
+	| pattern_struct ',' opt_newlines IDENT ':' pattern
+	{
+		structPattern := $1.(*ast.StructPattern)
+		structPattern.Fields = append(structPattern.Fields, $4.Lit)
+		structPattern.Values = append(structPattern.Values, $6)
+		$$ = structPattern
+	}
+
 expr_literals :
 	'-' NUMBER
 	{
@@ -959,6 +1614,10 @@ expr_literals :
 		$$ = &ast.LiteralExpr{Literal: stringToValue($1.Lit)}
 		$$.SetPosition($1.Position())
 	}
+	| FSTRING
+	{
+		$$ = $1
+	}
 	| TRUE
 	{
 		$$ = &ast.LiteralExpr{Literal: trueValue}
@@ -993,6 +1652,23 @@ expr_map :
 		$$.Keys = append($$.Keys, $4)
 		$$.Values = append($$.Values, $6)
 	}
+	| VARARG expr
+	{
+		spread := &ast.SpreadExpr{Expr: $2}
+		spread.SetPosition($1.Position())
+		$$ = &ast.MapExpr{Keys: []ast.Expr{spread}, Values: []ast.Expr{nil}}
+	}
+	| expr_map ',' opt_newlines VARARG expr
+	{
+		if $1.Keys == nil {
+			yylex.Error("syntax error: unexpected ','")
+			return 1
+		}
+		spread := &ast.SpreadExpr{Expr: $5}
+		spread.SetPosition($4.Position())
+		$$.Keys = append($$.Keys, spread)
+		$$.Values = append($$.Values, nil)
+	}
 
 expr_slice :
 	expr_ident '[' expr ':' expr ']'
@@ -1265,6 +1941,21 @@ term :
 	| newlines
 	| ';'
 
+lambda_body :
+	expr %prec ARROW
+	{
+		$$ = &ast.ReturnStmt{Exprs: []ast.Expr{$1}}
+		$$.SetPosition($1.Position())
+	}
+	| ARROWBRACE compstmt '}'
+	{
+		$$ = $2
+	}
+
+lbrace :
+	'{'
+	| LITBRACE
+
 opt_newlines : 
 	/* nothing */
 	| newlines
@@ -0,0 +1,140 @@
+package vfs
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type dirFS struct {
+	root string
+}
+
+// NewDir returns a FS rooted at the host directory root.
+//
+// Unlike os.DirFS, names whose symbolic links resolve outside of root are rejected with fs.ErrPermission.
+// Links are checked before each operation, so the host must not change them while scripts run.
+func NewDir(root string) FS {
+	return &dirFS{root: root}
+}
+
+// maxLinks is the number of symbolic links resolve follows before failing.
+const maxLinks = 255
+
+// resolve returns the path with its symbolic links resolved.
+// Unlike filepath.EvalSymlinks, the path does not have to exist,
+// so the paths of files that are going to be created are resolved through their existing parents.
+// Links is the number of links followed so far.
+func resolve(path string, links int) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return resolved, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+	dir := filepath.Dir(path)
+	if dir == path {
+		return path, nil
+	}
+	dir, err = resolve(dir, links)
+	if err != nil {
+		return "", err
+	}
+	path = filepath.Join(dir, filepath.Base(path))
+
+	// a link to a path that does not exist
+	target, err := os.Readlink(path)
+	if err != nil {
+		return path, nil
+	}
+	if links++; links > maxLinks {
+		return "", fmt.Errorf("too many links: %s", path)
+	}
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(dir, target)
+	}
+	return resolve(target, links)
+}
+
+// path returns the host path of name, after checking that it does not resolve outside of the root.
+// The root itself is only valid for open.
+func (d *dirFS) path(op, name string) (string, error) {
+	if op == "open" {
+		if !fs.ValidPath(name) {
+			return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+		}
+	} else if err := checkPath(op, name); err != nil {
+		return "", err
+	}
+
+	root, err := filepath.Abs(d.root)
+	if err == nil {
+		root, err = resolve(root, 0)
+	}
+	if err != nil {
+		return "", &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	path := filepath.Join(d.root, filepath.FromSlash(name))
+	resolved, err := filepath.Abs(path)
+	if err == nil {
+		resolved, err = resolve(resolved, 0)
+	}
+	if err != nil {
+		return "", &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrPermission}
+	}
+	return path, nil
+}
+
+// hostError replaces the host path in the error of an operation on name by name, so it is not shown to the scripts.
+func hostError(name string, err error) error {
+	if e, ok := err.(*fs.PathError); ok {
+		e.Path = name
+	}
+	return err
+}
+
+func (d *dirFS) Open(name string) (fs.File, error) {
+	path, err := d.path("open", name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, hostError(name, err)
+	}
+	return f, nil
+}
+
+func (d *dirFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	path, err := d.path("write", name)
+	if err != nil {
+		return err
+	}
+	return hostError(name, os.WriteFile(path, data, perm))
+}
+
+func (d *dirFS) Remove(name string) error {
+	path, err := d.path("remove", name)
+	if err != nil {
+		return err
+	}
+	return hostError(name, os.Remove(path))
+}
+
+func (d *dirFS) MkdirAll(name string, perm fs.FileMode) error {
+	if name == "." {
+		return nil
+	}
+	path, err := d.path("mkdir", name)
+	if err != nil {
+		return err
+	}
+	return hostError(name, os.MkdirAll(path, perm))
+}
@@ -0,0 +1,222 @@
+package vfs
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type memFS struct {
+	mu    sync.RWMutex
+	files map[string]*memFile
+}
+
+// memFile is a file or an explicitly created directory of a memFS.
+// Files are replaced instead of modified, so opened files keep their content.
+type memFile struct {
+	data    []byte
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+// memInfo describes a file or a directory of a memFS. It implements fs.FileInfo and fs.DirEntry.
+type memInfo struct {
+	name string
+	file *memFile
+}
+
+func (i *memInfo) Name() string               { return i.name }
+func (i *memInfo) Size() int64                { return int64(len(i.file.data)) }
+func (i *memInfo) Mode() fs.FileMode          { return i.file.mode }
+func (i *memInfo) Type() fs.FileMode          { return i.file.mode.Type() }
+func (i *memInfo) ModTime() time.Time         { return i.file.modTime }
+func (i *memInfo) IsDir() bool                { return i.file.mode.IsDir() }
+func (i *memInfo) Sys() interface{}           { return nil }
+func (i *memInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+// openMemFile is an opened file of a memFS.
+type openMemFile struct {
+	*bytes.Reader
+	info *memInfo
+}
+
+func (f *openMemFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *openMemFile) Close() error               { return nil }
+
+// memDir is an opened directory of a memFS.
+type memDir struct {
+	path    string
+	info    *memInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *memDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *memDir) Close() error               { return nil }
+
+func (d *memDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.path, Err: fs.ErrInvalid}
+}
+
+func (d *memDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	entries := d.entries[d.offset:]
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	d.offset += len(entries)
+	return entries, nil
+}
+
+// NewMem returns an empty in-memory FS.
+func NewMem() FS {
+	return &memFS{files: make(map[string]*memFile)}
+}
+
+// implicitDir is the file of the directories that are only the parents of other files.
+var implicitDir = &memFile{mode: fs.ModeDir | 0555}
+
+// stat returns the info of name, which can be a directory implied by the files under it.
+// It must be called with the lock held.
+func (m *memFS) stat(name string) (*memInfo, bool) {
+	if f, ok := m.files[name]; ok {
+		return &memInfo{name: path.Base(name), file: f}, true
+	}
+	if name == "." {
+		return &memInfo{name: ".", file: implicitDir}, true
+	}
+	prefix := name + "/"
+	for file := range m.files {
+		if strings.HasPrefix(file, prefix) {
+			return &memInfo{name: path.Base(name), file: implicitDir}, true
+		}
+	}
+	return nil, false
+}
+
+// entries returns the entries of the directory name sorted by name.
+// It must be called with the lock held.
+func (m *memFS) entries(name string) []fs.DirEntry {
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	for file := range m.files {
+		if !strings.HasPrefix(file, prefix) {
+			continue
+		}
+		child := strings.SplitN(file[len(prefix):], "/", 2)[0]
+		if seen[child] {
+			continue
+		}
+		seen[child] = true
+		info, _ := m.stat(prefix + child)
+		entries = append(entries, info)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries
+}
+
+func (m *memFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	info, ok := m.stat(name)
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if info.IsDir() {
+		return &memDir{path: name, info: info, entries: m.entries(name)}, nil
+	}
+	return &openMemFile{Reader: bytes.NewReader(info.file.data), info: info}, nil
+}
+
+// checkParents returns an error if any parent of name is a file.
+// It must be called with the lock held.
+func (m *memFS) checkParents(op, name string) error {
+	for dir := path.Dir(name); dir != "."; dir = path.Dir(dir) {
+		if f, ok := m.files[dir]; ok && !f.mode.IsDir() {
+			return &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+		}
+	}
+	return nil
+}
+
+func (m *memFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if err := checkPath("write", name); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkParents("write", name); err != nil {
+		return err
+	}
+	if info, ok := m.stat(name); ok && info.IsDir() {
+		return &fs.PathError{Op: "write", Path: name, Err: fs.ErrExist}
+	}
+	m.files[name] = &memFile{
+		data:    append([]byte(nil), data...),
+		mode:    perm.Perm(),
+		modTime: time.Now(),
+	}
+	return nil
+}
+
+func (m *memFS) Remove(name string) error {
+	if err := checkPath("remove", name); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info, ok := m.stat(name)
+	if !ok {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrNotExist}
+	}
+	if info.IsDir() && len(m.entries(name)) > 0 {
+		return &fs.PathError{Op: "remove", Path: name, Err: fs.ErrInvalid}
+	}
+	delete(m.files, name)
+	return nil
+}
+
+func (m *memFS) MkdirAll(name string, perm fs.FileMode) error {
+	if name == "." {
+		return nil
+	}
+	if err := checkPath("mkdir", name); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.checkParents("mkdir", name); err != nil {
+		return err
+	}
+	if info, ok := m.stat(name); ok {
+		if info.IsDir() {
+			return nil
+		}
+		return &fs.PathError{Op: "mkdir", Path: name, Err: fs.ErrExist}
+	}
+	m.files[name] = &memFile{
+		mode:    fs.ModeDir | perm.Perm(),
+		modTime: time.Now(),
+	}
+	return nil
+}
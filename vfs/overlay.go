@@ -0,0 +1,103 @@
+package vfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"sort"
+)
+
+type overlayFS struct {
+	lower fs.FS
+	upper FS
+}
+
+// Overlay returns a FS that reads from upper and then from lower.
+// Writes always go to upper, so lower is never modified.
+func Overlay(lower fs.FS, upper FS) FS {
+	return &overlayFS{lower: lower, upper: upper}
+}
+
+// overlayDir is a directory present in upper that lists the entries of both layers.
+type overlayDir struct {
+	fs.File
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *overlayDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	entries := d.entries[d.offset:]
+	if n > 0 && len(entries) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	d.offset += len(entries)
+	return entries, nil
+}
+
+func (o *overlayFS) Open(name string) (fs.File, error) {
+	f, err := o.upper.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return o.lower.Open(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := f.Stat()
+	if err != nil || !fi.IsDir() {
+		return f, err
+	}
+	entries, err := o.ReadDir(name)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &overlayDir{File: f, entries: entries}, nil
+}
+
+// ReadDir merges the entries of both layers. Entries of upper hide the ones of lower.
+func (o *overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	upper, uerr := fs.ReadDir(o.upper, name)
+	lower, lerr := fs.ReadDir(o.lower, name)
+	if uerr != nil && lerr != nil {
+		return nil, uerr
+	}
+
+	entries := make(map[string]fs.DirEntry, len(upper)+len(lower))
+	for _, e := range lower {
+		entries[e.Name()] = e
+	}
+	for _, e := range upper {
+		entries[e.Name()] = e
+	}
+
+	list := make([]fs.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name() < list[j].Name()
+	})
+	return list, nil
+}
+
+func (o *overlayFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return o.upper.WriteFile(name, data, perm)
+}
+
+func (o *overlayFS) Remove(name string) error {
+	err := o.upper.Remove(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if _, lerr := fs.Stat(o.lower, name); lerr == nil {
+			return &fs.PathError{Op: "remove", Path: name, Err: ErrReadOnly}
+		}
+	}
+	return err
+}
+
+func (o *overlayFS) MkdirAll(name string, perm fs.FileMode) error {
+	return o.upper.MkdirAll(name, perm)
+}
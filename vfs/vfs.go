@@ -0,0 +1,75 @@
+// Package vfs implements the virtual filesystems used by the fs package of the scripts.
+package vfs
+
+import (
+	"errors"
+	"io/fs"
+
+	"github.com/dgrr/pako/env"
+)
+
+// FS is a filesystem that can be read and written by the scripts.
+//
+// Names are slash-separated paths as accepted by fs.ValidPath.
+type FS interface {
+	fs.FS
+	// WriteFile writes data to the file name, creating it if necessary.
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	// Remove removes the file or empty directory name.
+	Remove(name string) error
+	// MkdirAll creates the directory name along with any necessary parents.
+	MkdirAll(name string, perm fs.FileMode) error
+}
+
+var (
+	// ErrReadOnly is returned when writing to a read-only filesystem.
+	ErrReadOnly = errors.New("read-only filesystem")
+	// ErrNotBound is returned when importing the fs package in an Env without a filesystem bound with Bind.
+	ErrNotBound = errors.New("no filesystem bound to the environment")
+)
+
+type fsKey struct{}
+
+// Bind sets fsys as the filesystem of the fs package for the scripts run in e and in its child scopes.
+// Each tenant should get its own Env with its own filesystem.
+func Bind(e *env.Env, fsys FS) {
+	e.SetHostValue(fsKey{}, fsys)
+}
+
+// FromEnv returns the filesystem bound to e or to its parent scopes.
+func FromEnv(e *env.Env) (FS, bool) {
+	fsys, ok := e.HostValue(fsKey{})
+	if !ok {
+		return nil, false
+	}
+	return fsys.(FS), true
+}
+
+type readOnlyFS struct {
+	fs.FS
+}
+
+// ReadOnly returns a FS that can read from fsys but fails on every write.
+func ReadOnly(fsys fs.FS) FS {
+	return &readOnlyFS{fsys}
+}
+
+func (r *readOnlyFS) WriteFile(name string, _ []byte, _ fs.FileMode) error {
+	return &fs.PathError{Op: "write", Path: name, Err: ErrReadOnly}
+}
+
+func (r *readOnlyFS) Remove(name string) error {
+	return &fs.PathError{Op: "remove", Path: name, Err: ErrReadOnly}
+}
+
+func (r *readOnlyFS) MkdirAll(name string, _ fs.FileMode) error {
+	return &fs.PathError{Op: "mkdir", Path: name, Err: ErrReadOnly}
+}
+
+// checkPath returns an error if name is not a valid path for op.
+func checkPath(op, name string) error {
+	if !fs.ValidPath(name) || name == "." {
+		return &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package vfs
+
+import (
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func writeFiles(t *testing.T, fsys FS) {
+	if err := fsys.MkdirAll("a/b", 0755); err != nil {
+		t.Fatalf("MkdirAll error: %v", err)
+	}
+	if err := fsys.WriteFile("a/b/c.txt", []byte("c"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	if err := fsys.WriteFile("d.txt", []byte("d"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+}
+
+func TestMem(t *testing.T) {
+	fsys := NewMem()
+	writeFiles(t, fsys)
+
+	if err := fstest.TestFS(fsys, "a/b/c.txt", "d.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fsys.WriteFile("d.txt/e", nil, 0644); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, fs.ErrInvalid)
+	}
+	if err := fsys.WriteFile("../e", nil, 0644); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, fs.ErrInvalid)
+	}
+	if err := fsys.Remove("a/b"); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("Remove error - received: %v - expected: %v", err, fs.ErrInvalid)
+	}
+	if err := fsys.Remove("a/b/c.txt"); err != nil {
+		t.Errorf("Remove error: %v", err)
+	}
+	if _, err := fs.Stat(fsys, "a/b/c.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat error - received: %v - expected: %v", err, fs.ErrNotExist)
+	}
+}
+
+func TestDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fsys := NewDir(dir)
+	writeFiles(t, fsys)
+
+	if err := fstest.TestFS(fsys, "a/b/c.txt", "d.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := fsys.WriteFile("../e", nil, 0644); !errors.Is(err, fs.ErrInvalid) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, fs.ErrInvalid)
+	}
+	if _, err := fsys.Open("e"); !errors.Is(err, fs.ErrNotExist) || err.Error() != "open e: no such file or directory" {
+		t.Errorf("Open error - received: %v - expected: open e: no such file or directory", err)
+	}
+
+	outside, err := ioutil.TempDir("", "vfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+	if err = ioutil.WriteFile(filepath.Join(outside, "f.txt"), []byte("f"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(outside, filepath.Join(dir, "out")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(filepath.Join(outside, "new"), filepath.Join(dir, "dangling")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink("a/b", filepath.Join(dir, "in")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := fs.ReadFile(fsys, "out/f.txt"); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("ReadFile error - received: %v - expected: %v", err, fs.ErrPermission)
+	}
+	if _, err := fs.ReadDir(fsys, "out"); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("ReadDir error - received: %v - expected: %v", err, fs.ErrPermission)
+	}
+	if err := fsys.WriteFile("out/g.txt", nil, 0644); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, fs.ErrPermission)
+	}
+	if err := fsys.WriteFile("dangling", nil, 0644); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, fs.ErrPermission)
+	}
+	if _, err := os.Lstat(filepath.Join(outside, "new")); !os.IsNotExist(err) {
+		t.Errorf("file was created outside of the root")
+	}
+	if err := fsys.MkdirAll("out/h", 0755); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("MkdirAll error - received: %v - expected: %v", err, fs.ErrPermission)
+	}
+	b, err := fs.ReadFile(fsys, "in/c.txt")
+	if err != nil || string(b) != "c" {
+		t.Errorf("ReadFile - received: %q, %v - expected: %q", b, err, "c")
+	}
+}
+
+func TestOverlay(t *testing.T) {
+	lower := NewMem()
+	writeFiles(t, lower)
+
+	fsys := Overlay(ReadOnly(lower), NewMem())
+	if err := fsys.WriteFile("a/e.txt", []byte("e"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	if err := fsys.WriteFile("d.txt", []byte("upper"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+
+	if err := fstest.TestFS(fsys, "a/b/c.txt", "a/e.txt", "d.txt"); err != nil {
+		t.Fatal(err)
+	}
+	b, err := fs.ReadFile(fsys, "d.txt")
+	if err != nil || string(b) != "upper" {
+		t.Errorf("ReadFile - received: %q, %v - expected: %q", b, err, "upper")
+	}
+	b, err = fs.ReadFile(lower, "d.txt")
+	if err != nil || string(b) != "d" {
+		t.Errorf("ReadFile - received: %q, %v - expected: %q", b, err, "d")
+	}
+	if err := fsys.Remove("a/b/c.txt"); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Remove error - received: %v - expected: %v", err, ErrReadOnly)
+	}
+	if err := ReadOnly(lower).WriteFile("f.txt", nil, 0644); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("WriteFile error - received: %v - expected: %v", err, ErrReadOnly)
+	}
+}
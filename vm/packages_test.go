@@ -2,11 +2,15 @@ package vm
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/dgrr/pako/env"
 	_ "github.com/dgrr/pako/packages"
+	"github.com/dgrr/pako/vfs"
 )
 
 func TestImport(t *testing.T) {
@@ -37,6 +41,115 @@ func TestImport(t *testing.T) {
 	env.Packages = envPackages
 }
 
+func TestImportLazy(t *testing.T) {
+	t.Parallel()
+
+	env.RegisterPackage("testLazyPackage", func() env.Package {
+		return env.Package{
+			Values: map[string]reflect.Value{"a": reflect.ValueOf(1)},
+			Types:  map[string]reflect.Type{"b": reflect.TypeOf(true)},
+			Init: func(pack *env.Env) error {
+				prefix, err := pack.Get("prefix")
+				if err != nil {
+					return err
+				}
+				return pack.Define("log", func(s string) string { return prefix.(string) + s })
+			},
+		}
+	})
+	tests := []Test{
+		{Script: `import "testLazyPackage"; testLazyPackage.a`, Input: map[string]interface{}{"prefix": ""}, RunOutput: 1},
+		{Script: `import "testLazyPackage"; new(testLazyPackage.b)`, Input: map[string]interface{}{"prefix": ""}, RunOutput: new(bool)},
+		{Script: `import "testLazyPackage"; testLazyPackage.log("a")`, Input: map[string]interface{}{"prefix": "run: "}, RunOutput: "run: a"},
+		{Script: `import "testLazyPackage"`, RunError: fmt.Errorf("import Init error: undefined symbol 'prefix'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	policy := &Policy{Deny: []string{"testLazyPackage.log"}}
+	tests = []Test{
+		{Script: `import "testLazyPackage"; testLazyPackage.a`, Input: map[string]interface{}{"prefix": ""}, RunOutput: 1},
+		{Script: `import "testLazyPackage"; testLazyPackage.log("a")`, Input: map[string]interface{}{"prefix": ""}, RunError: fmt.Errorf("undefined symbol 'log'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Policy: policy})
+
+	fail := true
+	env.RegisterPackage("testPanicPackage", func() env.Package {
+		if fail {
+			fail = false
+			panic("load failed")
+		}
+		return env.Package{Values: map[string]reflect.Value{"a": reflect.ValueOf(1)}}
+	})
+	func() {
+		defer func() {
+			if r := recover(); r != "load failed" {
+				t.Errorf("GetPackage panic - received: %v - expected: load failed", r)
+			}
+		}()
+		env.GetPackage("testPanicPackage")
+	}()
+	tests = []Test{
+		{Script: `import "testPanicPackage"; testPanicPackage.a`, RunOutput: 1},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestImportPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &Policy{Allow: []string{"strings", "os.Getenv", "os.Getpid"}, Deny: []string{"strings.Repeat"}}
+	tests := []Test{
+		{Script: `import "strings"; strings.ToUpper("a")`, RunOutput: "A"},
+		{Script: `import "strings"; strings.Repeat("a", 2)`, RunError: fmt.Errorf("undefined symbol 'Repeat'")},
+		{Script: `import "os"; os.Getenv("PAKO_POLICY_TEST")`, RunOutput: ""},
+		{Script: `import "os"; os.RemoveAll("a")`, RunError: fmt.Errorf("undefined symbol 'RemoveAll'")},
+		{Script: `import "regexp"`, RunError: fmt.Errorf("package not allowed: regexp")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Policy: policy})
+
+	policy = &Policy{Deny: []string{"os"}}
+	tests = []Test{
+		{Script: `import "os"`, RunError: fmt.Errorf("package not allowed: os")},
+		{Script: `import "strings"; strings.Repeat("a", 2)`, RunOutput: "aa"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Policy: policy})
+
+	dir, err := ioutil.TempDir("", "pako")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	policy = &Policy{FSRoots: []string{dir}}
+	tests = []Test{
+		{Script: `import "io/ioutil"; ioutil.WriteFile(a, "b", 0644)`, Input: map[string]interface{}{"a": filepath.Join(dir, "a")}, RunOutput: nil},
+		{Script: `import "io/ioutil"; toString(ioutil.ReadFile(a)[0])`, Input: map[string]interface{}{"a": filepath.Join(dir, "a"), "toString": func(b []byte) string { return string(b) }}, RunOutput: "b"},
+		{Script: `import "io/ioutil"; ioutil.ReadFile(a)[1]`, Input: map[string]interface{}{"a": filepath.Join(dir, "..", "a")}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Join(filepath.Dir(dir), "a"))},
+		{Script: `import "os"; os.Remove(a)`, Input: map[string]interface{}{"a": filepath.Dir(dir)}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Dir(dir))},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Policy: policy})
+
+	outside, err := ioutil.TempDir("", "pako")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+	if err = os.Symlink(outside, filepath.Join(dir, "out")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(filepath.Join(outside, "new"), filepath.Join(dir, "dangling")); err != nil {
+		t.Fatal(err)
+	}
+	tests = []Test{
+		{Script: `import "io/ioutil"; ioutil.WriteFile(a, "b", 0644)`, Input: map[string]interface{}{"a": filepath.Join(dir, "out", "a")}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Join(dir, "out", "a"))},
+		{Script: `import "io/ioutil"; ioutil.WriteFile(a, "b", 0644)`, Input: map[string]interface{}{"a": filepath.Join(dir, "dangling")}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Join(dir, "dangling"))},
+		{Script: `import "os"; os.Symlink("..", a)`, Input: map[string]interface{}{"a": filepath.Join(dir, "up")}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Dir(dir))},
+		{Script: `import "os"; os.Symlink("a", b)`, Input: map[string]interface{}{"b": filepath.Join(dir, "in")}, RunOutput: nil},
+		{Script: `import "path/filepath"; filepath.EvalSymlinks(a)[1]`, Input: map[string]interface{}{"a": filepath.Join(dir, "out")}, RunOutput: fmt.Errorf("path not allowed: " + filepath.Join(dir, "out"))},
+	}
+	runTests(t, tests, nil, &Options{Debug: true, Policy: policy})
+}
+
 func TestPackagesBytes(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +160,38 @@ func TestPackagesBytes(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestPackagesFS(t *testing.T) {
+	t.Parallel()
+
+	fsys := vfs.NewMem()
+	envSetupFunc := func(t *testing.T, e *env.Env) {
+		vfs.Bind(e, fsys)
+	}
+	tests := []Test{
+		{Script: `import "fs"; fs.WriteFile("pkg_test/a.txt", "a")`, RunOutput: nil},
+		{Script: `import "fs"; b = fs.ReadFile("pkg_test/a.txt")?; toString(b)`, Input: map[string]interface{}{"toString": func(b []byte) string { return string(b) }}, RunOutput: "a"},
+		{Script: `import "fs"; fs.Glob("pkg_test/*.txt")?`, RunOutput: []string{"pkg_test/a.txt"}},
+		{Script: `import "fs"; fs.Stat("pkg_test")?.IsDir()`, RunOutput: true},
+		{Script: `import "fs"; _, err = fs.ReadFile("../a.txt"); err.Error()`, RunOutput: "open ../a.txt: invalid argument"},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+
+	// each Env gets its own filesystem
+	other := vfs.NewMem()
+	envSetupFunc = func(t *testing.T, e *env.Env) {
+		vfs.Bind(e, other)
+	}
+	tests = []Test{
+		{Script: `import "fs"; fs.Glob("pkg_test/*.txt")?`, RunOutput: []string(nil)},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+
+	tests = []Test{
+		{Script: `import "fs"`, RunError: fmt.Errorf("import Init error: no filesystem bound to the environment")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestPackagesJson(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/dgrr/pako/ast"
 	"github.com/dgrr/pako/env"
@@ -17,6 +19,12 @@ type Options struct {
 	DisableGo      bool
 	MaxMemoryUsage int64
 	Debug          bool // run in Debug mode
+	// Policy restricts the packages and members the script can import.
+	// If nil, everything can be imported.
+	Policy *Policy
+	// DeferGracePeriod is how long the deferred calls of a function interrupted by the context can run
+	// before they are interrupted too. If zero, they can run for one second.
+	DeferGracePeriod time.Duration
 }
 
 type (
@@ -39,11 +47,14 @@ type (
 		stmt     ast.Stmt
 		expr     ast.Expr
 		operator ast.Operator
+		gen      *Generator
+		defers   []func(ctx context.Context) error
 
 		// outgoing
 		rv      reflect.Value
 		callErr int
 		err     error
+		label   string
 	}
 
 	vmStruct struct {
@@ -68,7 +79,7 @@ func (runInfo *runInfoStruct) runDecls(ctx context.Context) {
 			stmt := stmts.Stmts[i]
 			remove := false
 			switch st := stmt.(type) {
-			case *ast.StructStmt: // struct declaration
+			case *ast.StructStmt, *ast.InterfaceStmt, *ast.EnumStmt: // struct, interface or enum declaration
 				runInfo.stmt = st
 				runInfo.runSingleStmt() // struct declared if no nil is returned
 				remove = true
@@ -81,19 +92,23 @@ func (runInfo *runInfoStruct) runDecls(ctx context.Context) {
 						runInfo.expr = fn
 						ftyp := runInfo.funcExpr()
 						// we need to store the value in some way...
-						runInfo.env.DefineMethod(fn.Recv+"."+fn.Name, runInfo.rv)
+						name := methodFieldName(fn.Name)
+						runInfo.env.DefineMethod(fn.Recv+"."+name, runInfo.rv)
 
 						ns := make([]reflect.StructField, styp.NumField()+1)
 						for i := 0; i < styp.NumField(); i++ {
 							ns[i] = styp.Field(i)
 						}
 						ns[len(ns)-1] = reflect.StructField{
-							Name: fn.Name,
+							Name: name,
 							Type: ftyp,
+							// methods are not data, so they are left out of encodings
+							Tag: `json:"-"`,
 						}
 
 						runInfo.rv = nilValue
 						runInfo.env.DefineReflectType(fn.Recv, reflect.StructOf(ns))
+						runInfo.updateEmbeddings(fn.Recv)
 						remove = true
 					}
 				}
@@ -165,6 +180,8 @@ var (
 	runeType           = reflect.TypeOf('a')
 	interfaceType      = reflect.ValueOf([]interface{}{int64(1)}).Index(0).Type()
 	interfaceSliceType = reflect.TypeOf([]interface{}{})
+	interfaceMapType   = reflect.TypeOf(map[interface{}]interface{}{})
+	hashMapType        = reflect.TypeOf(&HashMap{})
 	reflectValueType   = reflect.TypeOf(reflect.Value{})
 	errorType          = reflect.ValueOf([]error{nil}).Index(0).Type()
 	vmErrorType        = reflect.TypeOf(&Error{})
@@ -257,13 +274,14 @@ func isNum(v reflect.Value) bool {
 }
 
 // equal returns true when lhsV and rhsV is same value.
-func equal(lhsV, rhsV reflect.Value) bool {
+// The __eq__ method of script structs is called with ctx and its error is returned.
+func equal(ctx context.Context, lhsV, rhsV reflect.Value) (bool, error) {
 	lhsIsNil, rhsIsNil := isNil(lhsV), isNil(rhsV)
 	if lhsIsNil && rhsIsNil {
-		return true
+		return true, nil
 	}
 	if (!lhsIsNil && rhsIsNil) || (lhsIsNil && !rhsIsNil) {
-		return false
+		return false, nil
 	}
 	if lhsV.Kind() == reflect.Interface || lhsV.Kind() == reflect.Ptr {
 		lhsV = lhsV.Elem()
@@ -271,10 +289,13 @@ func equal(lhsV, rhsV reflect.Value) bool {
 	if rhsV.Kind() == reflect.Interface || rhsV.Kind() == reflect.Ptr {
 		rhsV = rhsV.Elem()
 	}
+	if rv, ok, err := callOperatorMethod(ctx, lhsV, "__eq__", rhsV); ok {
+		return toBool(rv), err
+	}
 	if lhsV.Type().Implements(over.ComparisonReflectType) {
 		lhv := lhsV.Interface().(over.Comparison)
 		v := getUnderlyingType(rhsV)
-		return lhv.Equals(v) == nil
+		return lhv.Equals(v) == nil, nil
 	}
 
 	// Compare a string and a number.
@@ -285,7 +306,7 @@ func equal(lhsV, rhsV reflect.Value) bool {
 		rhsF, err := tryToFloat64(rhsV)
 		if err != nil {
 			// Couldn't convert RHS to a float, they can't be compared.
-			return false
+			return false, nil
 		}
 		rhsV = reflect.ValueOf(rhsF)
 	} else if lhsV.Kind() == reflect.String && isNum(rhsV) {
@@ -295,7 +316,7 @@ func equal(lhsV, rhsV reflect.Value) bool {
 			// if LHS is a float, e.g. "1.2", we need to set lhsV to a float64
 			lhsF, err := tryToFloat64(lhsV)
 			if err != nil {
-				return false
+				return false, nil
 			}
 			lhsV = reflect.ValueOf(lhsF)
 		} else {
@@ -304,32 +325,32 @@ func equal(lhsV, rhsV reflect.Value) bool {
 	}
 
 	if isNum(lhsV) && isNum(rhsV) {
-		return fmt.Sprintf("%v", lhsV) == fmt.Sprintf("%v", rhsV)
+		return fmt.Sprintf("%v", lhsV) == fmt.Sprintf("%v", rhsV), nil
 	}
 
 	// Try to compare bools to strings and numbers
 	if lhsV.Kind() == reflect.Bool || rhsV.Kind() == reflect.Bool {
 		lhsB, err := tryToBool(lhsV)
 		if err != nil {
-			return false
+			return false, nil
 		}
 		rhsB, err := tryToBool(rhsV)
 		if err != nil {
-			return false
+			return false, nil
 		}
-		return lhsB == rhsB
+		return lhsB == rhsB, nil
 	}
 
-	return reflect.DeepEqual(lhsV.Interface(), rhsV.Interface())
+	return reflect.DeepEqual(lhsV.Interface(), rhsV.Interface()), nil
 }
 
-func getMapIndex(key reflect.Value, aMap reflect.Value) reflect.Value {
+func getMapIndex(ctx context.Context, key reflect.Value, aMap reflect.Value) reflect.Value {
 	if aMap.IsNil() {
 		return nilValue
 	}
 
 	var err error
-	key, err = convertReflectValueToType(key, aMap.Type().Key())
+	key, err = convertReflectValueToType(ctx, key, aMap.Type().Key())
 	if err != nil {
 		return nilValue
 	}
@@ -492,7 +513,18 @@ func makeType(runInfo *runInfoStruct, typeStruct *ast.TypeStruct) reflect.Type {
 				return nil
 			}
 
-			fields = append(fields, reflect.StructField{Name: typeStruct.StructNames[i], Type: t})
+			embedded := i < len(typeStruct.StructEmbedded) && typeStruct.StructEmbedded[i]
+			if embedded && (t.Kind() != reflect.Struct || t.NumMethod() > 0) {
+				runInfo.err = fmt.Errorf("cannot embed type %v", t)
+				return nil
+			}
+
+			var tag reflect.StructTag
+			if i < len(typeStruct.StructTags) {
+				tag = reflect.StructTag(typeStruct.StructTags[i])
+			}
+
+			fields = append(fields, reflect.StructField{Name: typeStruct.StructNames[i], Type: t, Tag: tag, Anonymous: embedded})
 		}
 		if !runInfo.options.Debug {
 			// captures panic
@@ -518,6 +550,51 @@ func getTypeFromEnv(runInfo *runInfoStruct, typeStruct *ast.TypeStruct) reflect.
 	return t
 }
 
+// continueLoop returns true if runInfo.err is a continue statement for the loop with label, clearing it.
+// Continue statements without label are for the innermost loop.
+func (runInfo *runInfoStruct) continueLoop(label string) bool {
+	if runInfo.err != ErrContinue || runInfo.label != "" && runInfo.label != label {
+		return false
+	}
+	runInfo.err = nil
+	runInfo.label = ""
+	return true
+}
+
+// breakLoop clears runInfo.err if it is a break statement for the loop with label.
+// Break statements without label are for the innermost loop.
+func (runInfo *runInfoStruct) breakLoop(label string) {
+	if runInfo.err == ErrBreak && (runInfo.label == "" || runInfo.label == label) {
+		runInfo.err = nil
+		runInfo.label = ""
+	}
+}
+
+// runLoopBody runs body, an iteration of a loop, in runInfo.env, a new scope of the loop scope with the loop variables vars.
+// Afterwards the other values defined by body, except constants, are moved to the loop scope,
+// so the next iterations see them, while closures keep the loop variables of their own iteration.
+func (runInfo *runInfoStruct) runLoopBody(body ast.Stmt, vars []string) {
+	runInfo.stmt = body
+	runInfo.runSingleStmt()
+	runInfo.env.MoveToParent(func(symbol string) bool {
+		for _, name := range vars {
+			if symbol == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// breakLabel clears runInfo.err if it is a break statement with label.
+// It is used by switch and select statements, as break statements without label are for the enclosing loop.
+func (runInfo *runInfoStruct) breakLabel(label string) {
+	if runInfo.err == ErrBreak && label != "" && runInfo.label == label {
+		runInfo.err = nil
+		runInfo.label = ""
+	}
+}
+
 func (runInfo *runInfoStruct) isVMType(name string) (b bool) {
 	if runInfo == nil {
 		return
@@ -564,7 +641,7 @@ func makeValue(runInfo *runInfoStruct, name string, t reflect.Type) (reflect.Val
 		return value.Index(0), nil
 	case reflect.Ptr:
 		ptrV := reflect.New(t.Elem())
-		v, err := makeValue(runInfo, "", t.Elem())
+		v, err := makeValue(runInfo, name, t.Elem())
 		if err != nil {
 			return nilValue, err
 		}
@@ -579,7 +656,12 @@ func makeValue(runInfo *runInfoStruct, name string, t reflect.Type) (reflect.Val
 			if structV.Field(i).Kind() == reflect.Ptr {
 				continue
 			}
-			v, err := makeValue(runInfo, name+"."+t.Field(i).Name, structV.Field(i).Type())
+			fieldName := name + "." + t.Field(i).Name
+			if t.Field(i).Anonymous {
+				// the methods of embedded structs are defined with the name of their type
+				fieldName = t.Field(i).Name
+			}
+			v, err := makeValue(runInfo, fieldName, structV.Field(i).Type())
 			if err != nil {
 				return nilValue, err
 			}
@@ -593,6 +675,31 @@ func makeValue(runInfo *runInfoStruct, name string, t reflect.Type) (reflect.Val
 	return reflect.New(t).Elem(), nil
 }
 
+// methodFieldName returns the name of the struct field of the script method called name.
+// Fields must be exported, so operator methods like __add__ are stored with a prefix.
+func methodFieldName(name string) string {
+	if strings.HasPrefix(name, "_") {
+		return "Op" + name
+	}
+	return name
+}
+
+// fieldByName returns the exported struct field called name.
+// Fields renamed with the tag `pako:"name"` are only found by their new name.
+func fieldByName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath == "" && field.Tag.Get("pako") == name {
+			return field, true
+		}
+	}
+	field, found := t.FieldByName(methodFieldName(name))
+	if !found || field.PkgPath != "" || field.Tag.Get("pako") != "" {
+		return reflect.StructField{}, false
+	}
+	return field, true
+}
+
 // precedenceOfKinds returns the greater of two kinds
 // string > float > int
 func precedenceOfKinds(kind1 reflect.Kind, kind2 reflect.Kind) reflect.Kind {
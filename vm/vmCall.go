@@ -0,0 +1,233 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/dgrr/pako/env"
+)
+
+// ErrNotFuncPointer is returned by Bind when the destination is not a pointer to a func.
+var ErrNotFuncPointer = errors.New("destination is not a pointer to a func")
+
+// Call calls the function called name defined in env with args and returns its result.
+// Functions returning more than one value return them as []interface{}.
+// Errors of script functions are returned as *Error.
+func Call(ctx context.Context, env *env.Env, name string, args ...interface{}) (interface{}, error) {
+	f, err := env.GetValue(name)
+	if err != nil {
+		return nil, err
+	}
+
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		in[i] = reflect.ValueOf(arg)
+		if !in[i].IsValid() {
+			in[i] = nilValue
+		}
+	}
+
+	rv, err := callFunc(ctx, f, reflect.Value{}, in)
+	if err != nil {
+		return nil, err
+	}
+	return rv.Interface(), nil
+}
+
+// Bind sets the func pointed by fnPtr to call the function called name defined in env.
+// Arguments and results are converted to the types of the func.
+// If the first argument of the func is a context.Context, it is used to run the function.
+// Errors are returned if the last result of the func is an error, otherwise they panic.
+func Bind(env *env.Env, name string, fnPtr interface{}) error {
+	ptr := reflect.ValueOf(fnPtr)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Func {
+		return ErrNotFuncPointer
+	}
+	f, err := env.GetValue(name)
+	if err != nil {
+		return err
+	}
+	if f.Kind() == reflect.Interface && !f.IsNil() {
+		f = f.Elem()
+	}
+
+	t := ptr.Elem().Type()
+	if f.Type().AssignableTo(t) {
+		ptr.Elem().Set(f)
+		return nil
+	}
+
+	ptr.Elem().Set(makeBoundFunc(context.Background(), t, func(ctx context.Context, in []reflect.Value) (reflect.Value, error) {
+		return callFunc(ctx, f, reflect.Value{}, in)
+	}))
+	return nil
+}
+
+// makeBoundFunc returns a func of type t that runs call, converting the results to the types of t.
+// If the first argument of t is a context.Context, it is passed to call instead of ctx.
+func makeBoundFunc(ctx context.Context, t reflect.Type, call func(ctx context.Context, in []reflect.Value) (reflect.Value, error)) reflect.Value {
+	hasCtx := t.NumIn() > 0 && t.In(0) == contextType
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		ctx := ctx
+		if hasCtx {
+			if c, ok := in[0].Interface().(context.Context); ok {
+				ctx = c
+			}
+			in = in[1:]
+		}
+
+		rv, err := call(ctx, in)
+		if err != nil {
+			return errorReturnValues(t, err)
+		}
+		rvs, err := convertReturnValues(ctx, rv, t)
+		if err != nil {
+			return errorReturnValues(t, err)
+		}
+		return rvs
+	})
+}
+
+// callFunc calls the function f, that can be a script or a Go function, with args.
+// recv is the receiver of script methods and must be invalid for other functions.
+// It returns the result in the normal VM reflect.Value form.
+// Panics of the called function are returned as errors, like the VM does for the calls of the scripts.
+func callFunc(ctx context.Context, f reflect.Value, recv reflect.Value, args []reflect.Value) (rv reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rv = nilValue
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+		}
+	}()
+
+	if f.Kind() == reflect.Interface && !f.IsNil() {
+		f = f.Elem()
+	}
+	if f.Kind() != reflect.Func {
+		return nilValue, fmt.Errorf("cannot call type %v", f.Kind())
+	}
+
+	rt := f.Type()
+	isRunVMFunction := checkIfRunVMFunction(rt)
+	numIn := rt.NumIn()
+	hasRecv := isRunVMFunction && numIn >= 2 && rt.In(1) == vmStructType
+	if hasRecv && !recv.IsValid() {
+		return nilValue, fmt.Errorf("cannot call method without receiver")
+	}
+	if isRunVMFunction {
+		// for runVMFunction first arg is always context
+		numIn--
+		if hasRecv {
+			numIn--
+		}
+	}
+	if (!rt.IsVariadic() && len(args) != numIn) || (rt.IsVariadic() && len(args) < numIn-1) {
+		return nilValue, fmt.Errorf("function wants %v arguments but received %v", numIn, len(args))
+	}
+
+	in := make([]reflect.Value, 0, rt.NumIn())
+	if isRunVMFunction {
+		in = append(in, reflect.ValueOf(ctx))
+		if hasRecv {
+			in = append(in, reflect.ValueOf(&vmStruct{recv}))
+		}
+	}
+	numFixed := numIn
+	if rt.IsVariadic() {
+		numFixed--
+	}
+	for i := 0; i < numFixed; i++ {
+		if isRunVMFunction {
+			// have to do the double reflect.ValueOf that runVMFunction expects
+			in = append(in, reflect.ValueOf(args[i]))
+			continue
+		}
+		arg, err := convertReflectValueToType(ctx, args[i], rt.In(i))
+		if err != nil {
+			if isCallError(err) {
+				return nilValue, err
+			}
+			return nilValue, errors.New(conversionMessage(err, fmt.Sprintf("function wants argument type %v but received type %v", rt.In(i), args[i].Type())))
+		}
+		in = append(in, arg)
+	}
+
+	var rvs []reflect.Value
+	if rt.IsVariadic() {
+		sliceType := rt.In(rt.NumIn() - 1)
+		slice := reflect.MakeSlice(sliceType, 0, len(args)-numFixed)
+		for _, arg := range args[numFixed:] {
+			arg, err := convertReflectValueToType(ctx, arg, sliceType.Elem())
+			if err != nil {
+				if isCallError(err) {
+					return nilValue, err
+				}
+				return nilValue, errors.New(conversionMessage(err, fmt.Sprintf("function wants argument type %v but received type %v", sliceType.Elem(), arg.Type())))
+			}
+			slice = reflect.Append(slice, arg)
+		}
+		rvs = f.CallSlice(append(in, slice))
+	} else {
+		rvs = f.Call(in)
+	}
+
+	return processCallReturnValues(rvs, isRunVMFunction, true)
+}
+
+// convertReturnValues converts the VM reflect.Value rv to the results of the func type t.
+// If the last result of t is an error, rv can include it as its last value, otherwise it is set to nil.
+func convertReturnValues(ctx context.Context, rv reflect.Value, t reflect.Type) ([]reflect.Value, error) {
+	if rv.Kind() == reflect.Interface && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	numOut := t.NumOut()
+	hasErr := numOut > 0 && t.Out(numOut-1) == errorType
+	if hasErr && numOut > 1 && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Len() == numOut {
+		// the function returned the error value too
+		hasErr = false
+	}
+	if hasErr {
+		numOut--
+	}
+
+	rvs := make([]reflect.Value, 0, t.NumOut())
+	switch {
+	case numOut == 1:
+		value, err := convertReflectValueToType(ctx, rv, t.Out(0))
+		if err != nil {
+			if isCallError(err) {
+				return nil, err
+			}
+			return nil, errors.New(conversionMessage(err, fmt.Sprintf("function wants return type %v but received type %v", t.Out(0), rv.Type())))
+		}
+		rvs = append(rvs, value)
+	case numOut > 1:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return nil, fmt.Errorf("function wants %v return values but received %v", numOut, rv.Kind())
+		}
+		if rv.Len() < numOut {
+			return nil, fmt.Errorf("function wants %v return values but received %v values", numOut, rv.Len())
+		}
+		for i := 0; i < numOut; i++ {
+			value, err := convertReflectValueToType(ctx, rv.Index(i), t.Out(i))
+			if err != nil {
+				if isCallError(err) {
+					return nil, err
+				}
+				return nil, errors.New(conversionMessage(err, fmt.Sprintf("function wants return type %v but received type %v", t.Out(i), rv.Index(i).Type())))
+			}
+			rvs = append(rvs, value)
+		}
+	}
+
+	if hasErr {
+		rvs = append(rvs, reflect.Zero(errorType))
+	}
+	return rvs, nil
+}
@@ -0,0 +1,132 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dgrr/pako/env"
+)
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("join", strings.Join)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	err = e.Define("panics", func(v interface{}) { panic(v) })
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	_, err = Execute(e, nil, `
+fn add(a, b) { return a + b }
+fn pair(a) { return a, a + 1 }
+fn sum(a...) { s = 0; for v in a { s += v }; return s }
+fn fail() { throw "fail" }
+fn callPanics() { panics("boom") }
+notFunc = 1
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	tests := []struct {
+		name   string
+		args   []interface{}
+		output interface{}
+		err    error
+	}{
+		{name: "add", args: []interface{}{1, 2}, output: int64(3)},
+		{name: "add", args: []interface{}{"a", "b"}, output: "ab"},
+		{name: "pair", args: []interface{}{int64(1)}, output: []interface{}{int64(1), int64(2)}},
+		{name: "sum", args: []interface{}{int64(1), int64(2), int64(3)}, output: int64(6)},
+		{name: "sum", output: int64(0)},
+		{name: "join", args: []interface{}{[]string{"a", "b"}, "-"}, output: "a-b"},
+		{name: "add", args: []interface{}{1}, err: fmt.Errorf("function wants 2 arguments but received 1")},
+		{name: "fail", err: fmt.Errorf("fail")},
+		{name: "notFunc", err: fmt.Errorf("cannot call type int64")},
+		{name: "panics", args: []interface{}{"boom"}, err: fmt.Errorf("boom")},
+		{name: "panics", args: []interface{}{fmt.Errorf("error")}, err: fmt.Errorf("error")},
+		{name: "callPanics", err: fmt.Errorf("boom")},
+		{name: "missing", err: fmt.Errorf("undefined symbol 'missing'")},
+	}
+	for _, test := range tests {
+		output, err := Call(context.Background(), e, test.name, test.args...)
+		if err != nil || test.err != nil {
+			if err == nil || test.err == nil || err.Error() != test.err.Error() {
+				t.Errorf("Call %v error - received: %v - expected: %v", test.name, err, test.err)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(output, test.output) {
+			t.Errorf("Call %v output - received: %#v - expected: %#v", test.name, output, test.output)
+		}
+	}
+
+	_, err = Call(context.Background(), e, "fail")
+	if _, ok := err.(*Error); !ok {
+		t.Errorf("Call fail error - received: %T - expected: %T", err, &Error{})
+	}
+}
+
+func TestBind(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, `
+fn add(a, b) { return a + b }
+fn div(a, b) { if b == 0 { throw "division by zero" }; return a / b, a % b }
+fn wait() { for { } }
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	var add func(a, b int) int
+	err = Bind(e, "add", &add)
+	if err != nil {
+		t.Fatal("Bind error:", err)
+	}
+	if v := add(1, 2); v != 3 {
+		t.Errorf("add - received: %v - expected: %v", v, 3)
+	}
+
+	var div func(a, b int64) (int64, int64, error)
+	err = Bind(e, "div", &div)
+	if err != nil {
+		t.Fatal("Bind error:", err)
+	}
+	q, r, err := div(7, 2)
+	if err != nil || q != 3 || r != 1 {
+		t.Errorf("div - received: %v, %v, %v - expected: %v, %v, %v", q, r, err, 3, 1, nil)
+	}
+	_, _, err = div(1, 0)
+	if _, ok := err.(*Error); !ok || err.Error() != "division by zero" {
+		t.Errorf("div error - received: %#v - expected: %v", err, "division by zero")
+	}
+
+	var wait func(ctx context.Context) error
+	err = Bind(e, "wait", &wait)
+	if err != nil {
+		t.Fatal("Bind error:", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = wait(ctx)
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("wait error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+
+	err = Bind(e, "add", add)
+	if err != ErrNotFuncPointer {
+		t.Errorf("Bind error - received: %v - expected: %v", err, ErrNotFuncPointer)
+	}
+	err = Bind(e, "missing", &add)
+	if err == nil || err.Error() != "undefined symbol 'missing'" {
+		t.Errorf("Bind error - received: %v - expected: %v", err, "undefined symbol 'missing'")
+	}
+}
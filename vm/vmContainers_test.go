@@ -1,11 +1,14 @@
 package vm
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"reflect"
 	"testing"
 
+	"github.com/dgrr/pako/ast"
 	"github.com/dgrr/pako/env"
 	"github.com/dgrr/pako/parser"
 )
@@ -1924,3 +1927,436 @@ make(struct {
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+type testPoint struct {
+	X int64 `pako:"x"`
+	Y int64 `pako:"y"`
+}
+
+func newTestPoint(x, y int64) *testPoint { return &testPoint{X: x, Y: y} }
+
+func (p testPoint) Sum() int64 { return p.X + p.Y }
+
+func (p *testPoint) Scale(n int64) { p.X, p.Y = p.X*n, p.Y*n }
+
+type testHidden struct {
+	A int64
+	b int64
+}
+
+func TestDefineStruct(t *testing.T) {
+	t.Parallel()
+
+	envSetupFunc := func(t *testing.T, e *env.Env) {
+		err := e.DefineStruct("Point", &testPoint{}, map[string]interface{}{"New": newTestPoint})
+		if err != nil {
+			t.Fatal("DefineStruct error:", err)
+		}
+		err = e.DefineStruct("PointValue", testPoint{}, nil)
+		if err != nil {
+			t.Fatal("DefineStruct error:", err)
+		}
+		err = e.DefineStruct("Hidden", &testHidden{}, nil)
+		if err != nil {
+			t.Fatal("DefineStruct error:", err)
+		}
+	}
+	tests := []Test{
+		{Script: `a = make(Point); a.x = 1; a.y = 2; a`, RunOutput: &testPoint{X: 1, Y: 2}},
+		{Script: `a = make(Point); a.X`, RunError: fmt.Errorf("no member named 'X' for struct")},
+		{Script: `a = make(Point); a.X = 1`, RunError: fmt.Errorf("no member named 'X' for struct")},
+		{Script: `a = make(Hidden); a.A = 1; a.A`, RunOutput: int64(1)},
+		{Script: `a = make(Hidden); a.b`, RunError: fmt.Errorf("no member named 'b' for struct")},
+		{Script: `a = make(Hidden); a.b = 1`, RunError: fmt.Errorf("no member named 'b' for struct")},
+		{Script: `a = Point.New(1, 2); a.Sum()`, RunOutput: int64(3)},
+		{Script: `a = Point.New(1, 2); a.Scale(2); [a.x, a.y]`, RunOutput: []interface{}{int64(2), int64(4)}},
+		{Script: `a = make(PointValue); a.x = 1; a.Scale(3); a.Sum()`, RunOutput: int64(3)},
+		{Script: `a = make(Point); a.z`, RunError: fmt.Errorf("no member named 'z' for struct")},
+	}
+	runTests(t, tests, &TestOptions{EnvSetupFunc: &envSetupFunc}, &Options{Debug: true})
+}
+
+type testPair struct {
+	values []int64
+}
+
+func (p *testPair) Hash() uint64 {
+	var h uint64
+	for _, v := range p.values {
+		h = h*31 + uint64(v)
+	}
+	return h
+}
+
+func (p *testPair) Equals(v interface{}) error {
+	o, ok := v.(*testPair)
+	if !ok || len(o.values) != len(p.values) {
+		return fmt.Errorf("not equal")
+	}
+	for i := range p.values {
+		if p.values[i] != o.values[i] {
+			return fmt.Errorf("not equal")
+		}
+	}
+	return nil
+}
+
+func TestHashMap(t *testing.T) {
+	t.Parallel()
+
+	newPair := func(values ...int64) *testPair {
+		return &testPair{values: values}
+	}
+	pairs := map[string]interface{}{"p": newPair, "a": newPair(1, 2), "b": newPair(1, 2), "c": newPair(2, 1)}
+
+	structKey := `
+struct K {
+	Values []interface
+}
+fn |K| __hash__() { return len(self.Values) }
+fn |K| __eq__(o) { return toString(self.Values) == toString(o.Values) }
+fn key(v...) { k = make(K); k.Values = v; return k }
+`
+	keyInput := map[string]interface{}{"toString": func(v interface{}) string { return fmt.Sprint(v) }}
+
+	tests := []Test{
+		{Script: `m = {a: 1}; m[b]`, Input: pairs, RunOutput: int64(1)},
+		{Script: `m = {a: 1}; m[c]`, Input: pairs, RunOutput: nil},
+		{Script: `m = {"x": 1, a: 2}; [m["x"], m[b], len(m)]`, Input: pairs, RunOutput: []interface{}{int64(1), int64(2), int64(2)}},
+		{Script: `m = {"x": 1, a: 0}; m[a] = 2; m[b] = 3; [m["x"], m[a], len(m)]`, Input: pairs, RunOutput: []interface{}{int64(1), int64(3), int64(2)}},
+		{Script: `m = {a: 0}; m[a] = 1; m[c] = 2; [m[b], m[c]]`, Input: pairs, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `m = {a: 0}; n = m; n[c] = 2; m[c]`, Input: pairs, RunOutput: int64(2)},
+		{Script: `m = {"x": 1}; m[a] = 2`, Input: pairs, RunError: fmt.Errorf("cannot add hashable key of type *vm.testPair to map[interface {}]interface {}, create the map with a literal that has a hashable key"), Output: map[string]interface{}{"m": map[interface{}]interface{}{"x": int64(1)}}},
+		{Script: `m = {}; n = m; m[a] = 1`, Input: pairs, RunError: fmt.Errorf("cannot add hashable key of type *vm.testPair to map[interface {}]interface {}, create the map with a literal that has a hashable key")},
+		{Script: `m = {a: 1, c: 2, "x": 3}; delete(m, b); delete(m, "x"); [m[a], m[c], len(m)]`, Input: pairs, RunOutput: []interface{}{nil, int64(2), int64(1)}},
+		{Script: `m = {a: 1}; [b in m, c in m, p(1, 2) in m]`, Input: pairs, RunOutput: []interface{}{true, false, true}},
+		{Script: `m = {a: 1, c: 2}; s = 0; for k, v in m { if k == a || k == c { s += v } }; s`, Input: pairs, RunOutput: int64(3)},
+		{Script: `m = {a: 1, c: 2}; s = 0; for k in m { s += m[k] }; s`, Input: pairs, RunOutput: int64(3)},
+		{Script: `m = {a: "x"}; m[[1, 2]] = 3`, Input: pairs, RunError: fmt.Errorf("cannot use unhashable type []interface {} as map key")},
+		{Script: `m = {a: "x"}; m[[1, 2]]`, Input: pairs, RunError: fmt.Errorf("cannot use unhashable type []interface {} as map key")},
+		{Script: `m = {a: "x"}; [1] in m`, Input: pairs, RunError: fmt.Errorf("cannot use unhashable type []interface {} as map key")},
+		{Script: `m = {a: "x"}; delete(m, [1])`, Input: pairs, RunError: fmt.Errorf("cannot use unhashable type []interface {} as map key")},
+		{Script: `{a: "x", [1]: 2}`, Input: pairs, RunError: fmt.Errorf("cannot use unhashable type []interface {} as map key")},
+		{Script: `m = {p(0, 1): 0}; for i = 1; i < 100; i++ { m[p(i, 1)] = i }; m[p(50, 1)]`, Input: pairs, RunOutput: int64(50)},
+
+		{Script: structKey + `m = {key(1, 2): ""}; m[key(1, 2)] = "a"; [m[key(1, 2)], len(m)]`, Input: keyInput, RunOutput: []interface{}{"a", int64(1)}},
+		{Script: structKey + `m = {key(1, 2): "a", key(2, 1): "b"}; [m[key(2, 1)], len(m)]`, Input: keyInput, RunOutput: []interface{}{"b", int64(2)}},
+		{Script: structKey + `m = {key(1): "a"}; delete(m, key(1)); [key(1) in m, len(m)]`, Input: keyInput, RunOutput: []interface{}{false, int64(0)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestStructEmbedding(t *testing.T) {
+	t.Parallel()
+
+	structs := `
+struct Base {
+	ID int64
+}
+struct Animal {
+	Base
+	Legs int64
+}
+struct Dog {
+	Animal
+	Name string
+}
+fn |Dog| Sound() { return "woof" }
+fn |Animal| Sound() { return "..." }
+fn |Animal| Describe() { return self.Sound() + " with " + self.Legs + " legs" }
+fn |Base| Ident() { return "id " + self.ID }
+fn |Animal| __str__() { return "animal " + self.Legs }
+d = make(Dog)
+d.Legs = 4
+a = make(Animal)
+a.Legs = 2
+`
+	tests := []Test{
+		{Script: "struct A {\n\tb\n}", ParseError: fmt.Errorf("embedded struct types cannot start with a lowercase letter")},
+		{Script: "struct A {\n\tX int64\n\tñ int64\n}", ParseError: fmt.Errorf("struct declarations cannot start with a lowercase letter")},
+		{Script: "struct A {\n\tX int64\n\tÑ int64\n}\na = make(A)\na.Ñ = 1\na.Ñ", RunOutput: int64(1)},
+		{Script: "struct A {\n\tM\n}", Types: map[string]interface{}{"M": map[string]int64{}}, RunError: fmt.Errorf("cannot embed type map[string]int64")},
+
+		{Script: structs + `d.Legs`, RunOutput: int64(4)},
+		{Script: structs + `d.Animal.Legs`, RunOutput: int64(4)},
+		{Script: structs + `d.ID = 3; [d.ID, d.Animal.Base.ID]`, RunOutput: []interface{}{int64(3), int64(3)}},
+		{Script: structs + `d.Sound()`, RunOutput: "woof"},
+		{Script: structs + `a.Sound()`, RunOutput: "..."},
+		{Script: structs + `d.Animal.Sound()`, RunOutput: "..."},
+		{Script: structs + `d.Describe()`, RunOutput: "woof with 4 legs"},
+		{Script: structs + `a.Describe()`, RunOutput: "... with 2 legs"},
+		{Script: structs + `d.ID = 7; [d.Ident(), a.Ident()]`, RunOutput: []interface{}{"id 7", "id 0"}},
+		{Script: structs + `"" + d`, RunOutput: "animal 4"},
+		{Script: structs + `d.Other()`, RunError: fmt.Errorf("no member named 'Other' for struct")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, structs)
+	if err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	for _, name := range []string{"Dog.Describe", "Dog.Ident", "Animal.Ident"} {
+		if _, err := e.Method(name); err != nil {
+			t.Errorf("Method %v error: %v", name, err)
+		}
+	}
+	sound, err := e.Method("Dog.Sound")
+	if err != nil {
+		t.Fatalf("Method Dog.Sound error: %v", err)
+	}
+	rv, err := callFunc(context.Background(), sound, reflect.ValueOf(struct{}{}), nil)
+	if err != nil {
+		t.Fatalf("Dog.Sound error: %v", err)
+	}
+	if rv.Interface() != "woof" {
+		t.Errorf("Dog.Sound - received: %v - expected: woof", rv)
+	}
+}
+
+func TestStructTagsAndDefaults(t *testing.T) {
+	t.Parallel()
+
+	payload := `
+defaultPort = 8080
+struct Payload {
+	Name string "json:\"name\"",
+	Port int64 "json:\"port\"" = defaultPort,
+	Tags []string = ["a"],
+	Limits struct {
+		Max int64 = 10
+	},
+	Hidden bool ` + "`json:\"-\"`" + `
+}
+fn |Payload| Address() { return f"${self.Name}:${self.Port}" }
+`
+	tagOf := func(v interface{}, name string) string {
+		field, _ := reflect.TypeOf(v).FieldByName(name)
+		return string(field.Tag)
+	}
+
+	tests := []Test{
+		{Script: "struct A {\n\tB int64 \"x\" =\n}", ParseError: fmt.Errorf("syntax error")},
+		{Script: "struct A {\n\tB int64 = \"a\"\n}\nmake(A)", RunError: fmt.Errorf("cannot use type string as type int64 as default value of field B")},
+		{Script: "struct A {\n\tB int64 = c\n}\nmake(A)", RunError: fmt.Errorf("undefined symbol 'c'")},
+		{Script: "struct A {\n\tB int64 = 1\n}\nstruct A {\n\tB int64\n}\nmake(A).B", RunOutput: int64(0)},
+
+		{Script: payload + `make(Payload).Port`, RunOutput: int64(8080)},
+		{Script: payload + `make(Payload).Tags`, RunOutput: []string{"a"}},
+		{Script: payload + `make(Payload).Limits.Max`, RunOutput: int64(10)},
+		{Script: payload + `a = make(Payload); a.Name = "x"; a.Address()`, RunOutput: "x:8080"},
+		{Script: payload + `defaultPort = 9; make(Payload).Port`, RunOutput: int64(9)},
+		{Script: payload + `a = make(Payload); a.Tags[0] = "b"; make(Payload).Tags`, RunOutput: []string{"a"}},
+		{Script: payload + `fn f() { defaultPort = 1; return make(Payload).Port }; f()`, RunOutput: int64(1)},
+		{Script: payload + `new(Payload).Port`, RunOutput: int64(8080)},
+		{Script: payload + `a = new(Payload); a.Name = "x"; a.Address()`, RunOutput: "x:8080"},
+		{Script: payload + `make(*Payload).Limits.Max`, RunOutput: int64(10)},
+		{Script: payload + "struct Server {\n\tAPI Payload\n}\nmake(Server).API.Port", RunOutput: int64(8080)},
+		{Script: payload + "struct Server {\n\tPayload\n}\nServer{}.Port", RunOutput: int64(8080)},
+		{Script: payload + "module m {\n\tstruct Payload {\n\t\tPort int64 = 1\n\t}\n}\n[make(Payload).Port, make(m.Payload).Port, m.Payload{}.Port]", RunOutput: []interface{}{int64(8080), int64(1), int64(1)}},
+		{Script: payload + `module m { make(type Payload, p) }; make(m.Payload).Port`, Input: map[string]interface{}{"p": struct{ Port int64 }{}}, RunOutput: int64(0)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	value, err := Execute(env.NewEnv(), nil, payload+`p = make(Payload); p.Name = "api"; p`)
+	if err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	if tag := tagOf(value, "Name"); tag != `json:"name"` {
+		t.Errorf("Name tag - received: %q - expected: %q", tag, `json:"name"`)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	expected := `{"name":"api","port":8080,"Tags":["a"],"Limits":{"Max":10}}`
+	if string(data) != expected {
+		t.Errorf("Marshal - received: %s - expected: %s", data, expected)
+	}
+
+	decoded := reflect.New(reflect.TypeOf(value))
+	err = json.Unmarshal([]byte(`{"name":"web","port":80,"Hidden":true}`), decoded.Interface())
+	if err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if name, port := decoded.Elem().FieldByName("Name").String(), decoded.Elem().FieldByName("Port").Int(); name != "web" || port != 80 {
+		t.Errorf("Unmarshal - received: %v, %v - expected: web, 80", name, port)
+	}
+	if decoded.Elem().FieldByName("Hidden").Bool() {
+		t.Errorf("Unmarshal - Hidden was decoded")
+	}
+}
+
+func TestStructLiterals(t *testing.T) {
+	t.Parallel()
+
+	structs := `
+struct Some {
+	A int64,
+	B string = "default"
+}
+fn |Some| Sum() { return self.A + len(self.B) }
+`
+	lines := `
+struct Some {
+	A int64
+}
+struct Line {
+	From Some,
+	To *Some
+}
+`
+	types := map[string]interface{}{"Point": testPoint{}, "PointPtr": &testPoint{}}
+
+	tests := []Test{
+		{Script: structs + `Some{A: 1, A: 2}`, ParseError: fmt.Errorf("duplicate field name A in struct literal")},
+		{Script: structs + `Some{A: 1, 2}`, ParseError: fmt.Errorf("syntax error")},
+		{Script: structs + `"a".b{A: 1}`, ParseError: fmt.Errorf("syntax error: invalid struct literal type")},
+
+		{Script: structs + `a = Some{A: 20, B: "Hello"}; [a.A, a.B]`, RunOutput: []interface{}{int64(20), "Hello"}},
+		{Script: structs + `a = Some{20, "Hello"}; [a.A, a.B]`, RunOutput: []interface{}{int64(20), "Hello"}},
+		{Script: structs + `a = Some{
+	A: 1,
+	B: "ab",
+}
+a.Sum()`, RunOutput: int64(3)},
+		{Script: structs + `Some{A: 1}.B`, RunOutput: "default"},
+		{Script: structs + `Some{}.A`, RunOutput: int64(0)},
+		{Script: structs + `Some{A: 1.5}.A`, RunOutput: int64(1)},
+		{Script: structs + `a = &Some{A: 2}; a.A = 3; a.Sum()`, RunOutput: int64(10)},
+		{Script: lines + `l = Line{From: Some{A: 1}, To: &Some{A: 2}}; [l.From.A, l.To.A]`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: structs + `[Some{A: 1}, Some{A: 2}][1].A`, RunOutput: int64(2)},
+		{Script: structs + `1 + Some{A: 1}.A`, RunOutput: int64(2)},
+		{Script: structs + `a = Some{A: 1}; if a.A == 1 { a.A = 2 }; a.A`, RunOutput: int64(2)},
+		{Script: structs + `a = 1; if a { a = Some{A: 3}.A }; a`, RunOutput: int64(3)},
+		{Script: `a = true; if a{ "yes" }`, RunOutput: "yes"},
+		{Script: `a = false; if a{ "yes" } else if !a{ "no" }`, RunOutput: "no"},
+		{Script: `x = 1; switch x{ case 1: "one" }`, RunOutput: "one"},
+		{Script: `p = 2; match p{ case 2: "two" }`, RunOutput: "two"},
+		{Script: `a = 0; for v in []int64{1, 2}{ a += v }; a`, RunOutput: int64(3)},
+		{Script: structs + `a = 0; l = [1]; for v in l{ a = Some{A: v}.A }; a`, RunOutput: int64(1)},
+		{Script: structs + `a = 0; for v in [Some{A: 2}]{ a = v.A }; a`, RunOutput: int64(2)},
+		{Script: structs + `a = 0; if (Some{A: 1}).A == 1 { a = Some{A: 2}.A }; a`, RunOutput: int64(2)},
+		{Script: structs + `if Some{A: 1}.A == 1 { }`, ParseError: fmt.Errorf("syntax error")},
+		{Script: structs + `Some {A: 1}.A`, RunOutput: int64(1)},
+		{Script: lines + `l = Line {From: Some {A: 1}, To: &Some{A: 2}}; [l.From.A, l.To.A]`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: lines + `l = Line{From: Some{A: Some{A: 3}.A}}; l.From.A`, RunOutput: int64(3)},
+		{Script: structs + `a = Some{A: {"b": 2}["b"]}; a.A`, RunOutput: int64(2)},
+		{Script: structs + `a = [Some{A: 1}, Some{A: 2}]; m = {"a": Some{A: 3}}; a[1].A + m.a.A`, RunOutput: int64(5)},
+		{Script: structs + `a = 0; if a == 0 { if (Some{A: 1}).A == 1 { a = Some{A: 2}.A } }; a`, RunOutput: int64(2)},
+		{Script: `a = 0; for v in []int64 {1, 2} { a += v }; a`, RunOutput: int64(3)},
+		{Script: `a = 0; for v in [][]int64{[]int64{1}, []int64{2}} { a += v[0] }; a`, RunOutput: int64(3)},
+		{Script: `a = [1]; if a is []interface {
+	"yes"
+}`, RunOutput: "yes"},
+		{Script: `if {"a": true}["a"] { "yes" }`, RunOutput: "yes"},
+		{Script: structs + `module m { struct Inner {
+	C int64
+} }; m.Inner{C: 4}.C`, RunOutput: int64(4)},
+
+		{Script: `Point{x: 1, y: 2}`, Types: types, RunOutput: testPoint{X: 1, Y: 2}},
+		{Script: `Point{1, 2}`, Types: types, RunOutput: testPoint{X: 1, Y: 2}},
+		{Script: `PointPtr{x: 3}`, Types: types, RunOutput: &testPoint{X: 3}},
+		{Script: `&Point{x: 3}`, Types: types, RunOutput: &testPoint{X: 3}},
+
+		{Script: structs + `Some{C: 1}`, RunError: fmt.Errorf("no member named 'C' for struct")},
+		{Script: structs + `Some{Sum: 1}`, RunError: fmt.Errorf("no member named 'Sum' for struct")},
+		{Script: structs + `Some{A: "a"}`, RunError: fmt.Errorf("cannot use type string as type int64 as value of field A")},
+		{Script: structs + `Some{1}`, RunError: fmt.Errorf("too few values in struct literal of type Some")},
+		{Script: structs + `Some{1, "a", 2}`, RunError: fmt.Errorf("too many values in struct literal of type Some")},
+		{Script: structs + `Other{A: 1}`, RunError: fmt.Errorf("undefined type 'Other'")},
+		{Script: `a = 1; int64{A: 1}`, RunError: fmt.Errorf("int64 is not a struct type")},
+		{Script: structs + `Some{A: b}`, RunError: fmt.Errorf("undefined symbol 'b'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestStructLiteralErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	script := `
+struct Some {
+	A int64
+}
+a = Some{
+	A: 1,
+	B: 2,
+}
+`
+	_, err := Execute(env.NewEnv(), nil, script)
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Execute error - received: %v - expected: *Error", err)
+	}
+	expected := ast.Position{Line: 7, Column: 2}
+	if e.Message != "no member named 'B' for struct" || e.Pos != expected {
+		t.Errorf("Execute error - received: %v at %v - expected: no member named 'B' for struct at %v", e.Message, e.Pos, expected)
+	}
+}
+
+type testOptions struct {
+	Name    string
+	Port    int64
+	Point   *testPoint
+	Tags    []string
+	Timeout int64  `json:"timeout,omitempty"`
+	User    string `pako:"user" json:"login"`
+	Hidden  bool   `json:"-"`
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]interface{}{
+		"listen":    func(o testOptions) string { return fmt.Sprint(o.Name, ":", o.Port) },
+		"listenPtr": func(o *testOptions) *testOptions { return o },
+	}
+
+	tests := []Test{
+		{Script: `[1, ...]`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `{"a": 1, ...}`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `a = [2, 3]; [1, ...a, 4]`, RunOutput: []interface{}{int64(1), int64(2), int64(3), int64(4)}},
+		{Script: `a = [2, 3]; [...a]`, RunOutput: []interface{}{int64(2), int64(3)}},
+		{Script: `a = []; [...a, ...a]`, RunOutput: []interface{}{}},
+		{Script: `a = [1]; b = [...a]; b[0] = 2; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `[...a]`, Input: map[string]interface{}{"a": []int64{1, 2}}, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `a = [2, 3]; []int64{1, ...a}`, RunOutput: []int64{1, 2, 3}},
+		{Script: `fn f() { yield 1; yield 2 }; [...f(), 3]`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `[...nil]`, RunError: fmt.Errorf("cannot spread type nil in slice literal")},
+		{Script: `[...1]`, RunError: fmt.Errorf("cannot spread type int64 in slice literal")},
+		{Script: `[...{"a": 1}]`, RunError: fmt.Errorf("cannot spread type map[interface {}]interface {} in slice literal")},
+		{Script: `a = ["a"]; []int64{...a}`, RunError: fmt.Errorf("cannot use type string as type int64 as slice value")},
+
+		{Script: `a = {"a": 1, "b": 2}; {...a, "b": 3, "c": 4}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(3), "c": int64(4)}},
+		{Script: `a = {"a": 1, "b": 2}; {"b": 3, ...a}`, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = {"a": 1}; b = {...a}; b.a = 2; a`, RunOutput: map[interface{}]interface{}{"a": int64(1)}},
+		{Script: `{...a, "b": 2}`, Input: map[string]interface{}{"a": map[string]int64{"a": 1}}, RunOutput: map[interface{}]interface{}{"a": int64(1), "b": int64(2)}},
+		{Script: `a = {"a": 1}; map[string]int64{...a, "b": 2}`, RunOutput: map[string]int64{"a": 1, "b": 2}},
+		{Script: `{...nil}`, RunError: fmt.Errorf("cannot spread type nil in map literal")},
+		{Script: `{...[1]}`, RunError: fmt.Errorf("cannot spread type []interface {} in map literal")},
+		{Script: `a = {"a": "b"}; map[string]int64{...a}`, RunError: fmt.Errorf("cannot use type string as type int64 as map value")},
+
+		{Script: `listen({"Name": "localhost", "Port": 80})`, Input: input, RunOutput: "localhost:80"},
+		{Script: `defaults = {"Name": "localhost", "Port": 80}; listen({...defaults, "Port": 8080})`, Input: input, RunOutput: "localhost:8080"},
+		{Script: `listen({})`, Input: input, RunOutput: ":0"},
+		{Script: `listenPtr({"Name": "a", "Point": {"x": 1}, "Tags": ["b"]})`, Input: input, RunOutput: &testOptions{Name: "a", Point: &testPoint{X: 1}, Tags: []string{"b"}}},
+		{Script: `listenPtr({"timeout": 5, "user": "b", "Hidden": true})`, Input: input, RunOutput: &testOptions{Timeout: 5, User: "b", Hidden: true}},
+		{Script: `listen({"Host": "localhost"})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: no member named 'Host' for struct")},
+		{Script: `listen({"Port": "a"})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: cannot use type string as type int64 as value of member 'Port'")},
+		{Script: `listen({1: "a"})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: cannot use type int64 as struct member name")},
+		{Script: `listen({"User": "a"})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: no member named 'User' for struct")},
+		{Script: `listen({"login": "a"})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: no member named 'login' for struct")},
+		{Script: `listen({"Point": {"Z": 1}})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: cannot use type map[interface {}]interface {} as type *vm.testPoint as value of member 'Point': no member named 'Z' for struct")},
+		{Script: `listen({"Point": {"X": 1}})`, Input: input, RunError: fmt.Errorf("function wants argument type vm.testOptions but received type map[interface {}]interface {}: cannot use type map[interface {}]interface {} as type *vm.testPoint as value of member 'Point': no member named 'X' for struct")},
+		{Script: `struct Some {
+	A int64
+}
+fn |Some| Sum() { return self.A }
+struct Line {
+	From Some
+}
+l = Line{}; l.From = {"Sum": 1}`, RunError: fmt.Errorf("type map[interface {}]interface {} cannot be assigned to type struct { A int64; Sum func(context.Context, *vm.vmStruct) (reflect.Value, reflect.Value) \"json:\\\"-\\\"\" } for struct: no member named 'Sum' for struct")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
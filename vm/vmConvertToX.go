@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/dgrr/pako/over"
 )
@@ -27,7 +28,7 @@ func reflectValueSlicetoInterfaceSlice(valueSlice []reflect.Value) reflect.Value
 
 // convertReflectValueToType trys to covert the reflect.Value to the reflect.Type
 // if it can not, it returns the original rv and an error
-func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertReflectValueToType(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
 	if rt == interfaceType || rv.Type() == rt {
 		// if reflect.Type is interface or the types match, return the provided reflect.Value
 		return rv, nil
@@ -39,20 +40,24 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) &&
 		(rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array) {
 		// covert slice or array
-		return convertSliceOrArray(rv, rt)
+		return convertSliceOrArray(ctx, rv, rt)
+	}
+	if rv.Kind() == reflect.Map && (rt.Kind() == reflect.Struct || (rt.Kind() == reflect.Ptr && rt.Elem().Kind() == reflect.Struct)) {
+		// convert map to option struct
+		return convertMapToStruct(ctx, rv, rt)
 	}
 	if rv.Kind() == rt.Kind() {
 		// kind matches
 		switch rv.Kind() {
 		case reflect.Map:
 			// convert map
-			return convertMap(rv, rt)
+			return convertMap(ctx, rv, rt)
 		case reflect.Func:
 			// for runVMFunction conversions, call convertVMFunctionToType
-			return convertVMFunctionToType(rv, rt)
+			return convertVMFunctionToType(ctx, rv, rt)
 		case reflect.Ptr:
 			// both rv and rt are pointers, convert what they are pointing to
-			value, err := convertReflectValueToType(rv.Elem(), rt.Elem())
+			value, err := convertReflectValueToType(ctx, rv.Elem(), rt.Elem())
 			if err != nil {
 				return rv, err
 			}
@@ -72,7 +77,7 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 			return reflect.Zero(rt), nil
 		}
 		// try to convert the element
-		return convertReflectValueToType(rv.Elem(), rt)
+		return convertReflectValueToType(ctx, rv.Elem(), rt)
 	}
 
 	if rv.Type() == stringType {
@@ -98,6 +103,19 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 		}
 	}
 
+	if rt == stringType {
+		if value, ok, err := callOperatorMethod(ctx, rv, "__str__"); ok {
+			if err != nil {
+				return rv, err
+			}
+			s, err := toStringContext(ctx, value)
+			if err != nil {
+				return rv, err
+			}
+			return reflect.ValueOf(s), nil
+		}
+	}
+
 	// as reflectValueType implements over.String we need to exclude it
 	if rv.Type() != reflectValueType && rt == stringType && rv.Type().Implements(over.StringReflectType) {
 		v := rv.Interface().(over.String)
@@ -109,8 +127,34 @@ func convertReflectValueToType(rv reflect.Value, rt reflect.Type) (reflect.Value
 	return rv, errInvalidTypeConversion
 }
 
+// mapKeyError is the error of converting a map to a struct when one of its keys cannot be set as a member of it.
+type mapKeyError struct {
+	message string
+}
+
+// Error returns the message of the error, which names the key.
+func (err *mapKeyError) Error() string {
+	return err.message
+}
+
+// conversionMessage returns message, describing a conversion that failed with err,
+// followed by the error of the map key that made it fail if there is one.
+func conversionMessage(err error, message string) string {
+	if err, ok := err.(*mapKeyError); ok {
+		return message + ": " + err.message
+	}
+	return message
+}
+
+// isCallError returns true if the error of a conversion was returned by a script method called by it,
+// like __str__, instead of being a failed conversion.
+func isCallError(err error) bool {
+	_, ok := err.(*Error)
+	return ok || err == ErrInterrupt
+}
+
 // convertSliceOrArray trys to covert the reflect.Value slice or array to the slice or array reflect.Type
-func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertSliceOrArray(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
 	rtElemType := rt.Elem()
 
 	// try to covert elements to new slice/array
@@ -126,7 +170,7 @@ func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, erro
 	var err error
 	var v reflect.Value
 	for i := 0; i < rv.Len(); i++ {
-		v, err = convertReflectValueToType(rv.Index(i), rtElemType)
+		v, err = convertReflectValueToType(ctx, rv.Index(i), rtElemType)
 		if err != nil {
 			return rv, err
 		}
@@ -137,10 +181,77 @@ func convertSliceOrArray(rv reflect.Value, rt reflect.Type) (reflect.Value, erro
 	return value, nil
 }
 
+// convertMapToStruct trys to covert the reflect.Value map to the struct or pointer to struct reflect.Type
+// the map keys are the names of the fields to set, the other fields are left as zero values
+func convertMapToStruct(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+	structType := rt
+	if rt.Kind() == reflect.Ptr {
+		structType = rt.Elem()
+	}
+	ptrV := reflect.New(structType)
+	value := ptrV.Elem()
+
+	for _, key := range rv.MapKeys() {
+		name := key
+		if name.Kind() == reflect.Interface && !name.IsNil() {
+			name = name.Elem()
+		}
+		if name.Kind() != reflect.String {
+			return rv, &mapKeyError{message: "cannot use type " + name.Type().String() + " as struct member name"}
+		}
+		structField, found := mapKeyField(structType, name.String())
+		if !found {
+			return rv, &mapKeyError{message: "no member named '" + name.String() + "' for struct"}
+		}
+		field := value.FieldByIndex(structField.Index)
+		if !field.CanSet() {
+			return rv, &mapKeyError{message: "struct member '" + structField.Name + "' cannot be assigned"}
+		}
+		fieldValue := rv.MapIndex(key)
+		if fieldValue.Kind() == reflect.Interface && !fieldValue.IsNil() {
+			fieldValue = fieldValue.Elem()
+		}
+		if !fieldValue.IsValid() || (fieldValue.Kind() == reflect.Interface && fieldValue.IsNil()) {
+			continue
+		}
+		converted, err := convertReflectValueToType(ctx, fieldValue, field.Type())
+		if err != nil {
+			if isCallError(err) {
+				return rv, err
+			}
+			return rv, &mapKeyError{message: conversionMessage(err,
+				"cannot use type "+fieldValue.Type().String()+" as type "+field.Type().String()+" as value of member '"+name.String()+"'")}
+		}
+		field.Set(converted)
+	}
+
+	if rt.Kind() == reflect.Ptr {
+		return ptrV, nil
+	}
+	return value, nil
+}
+
+// mapKeyField returns the struct field of t set by the map key name when converting a map to t.
+// It is found like the members of struct literals, or by the name of its json tag, but cannot be a script method.
+func mapKeyField(t reflect.Type, name string) (reflect.StructField, bool) {
+	field, found := fieldByName(t, name)
+	if !found {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if f.PkgPath == "" && f.Tag.Get("pako") == "" && tag != "-" && tag != "" && strings.Split(tag, ",")[0] == name {
+				field, found = f, true
+				break
+			}
+		}
+	}
+	return field, found && !isMethodField(field)
+}
+
 // convertVMFunctionToType is for translating a runVMFunction into the correct type
 // so it can be passed to a Go function argument with the correct static types
 // it creates a translate function runVMConvertFunction
-func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
+func convertVMFunctionToType(ctx context.Context, rv reflect.Value, rt reflect.Type) (reflect.Value, error) {
 	// only translates runVMFunction type
 	if !checkIfRunVMFunction(rv.Type()) {
 		return rv, errInvalidTypeConversion
@@ -155,8 +266,7 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		// make the reflect.Value slice of each of the VM reflect.Value
 		args := make([]reflect.Value, 0, rt.NumIn()+1)
 		// for runVMFunction first arg is always context
-		// TOFIX: use normal context
-		args = append(args, reflect.ValueOf(context.Background()))
+		args = append(args, reflect.ValueOf(ctx))
 		for i := 0; i < rt.NumIn(); i++ {
 			// have to do the double reflect.ValueOf that runVMFunction expects
 			args = append(args, reflect.ValueOf(in[i]))
@@ -179,9 +289,12 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		if rt.NumOut() < 2 {
 			// Go function wants one return value
 			// will try to covert to reflect.Value correct type and return
-			rv, err = convertReflectValueToType(rv, rt.Out(0))
+			rv, err = convertReflectValueToType(ctx, rv, rt.Out(0))
 			if err != nil {
-				panic("function wants return type " + rt.Out(0).String() + " but received type " + rv.Type().String())
+				if isCallError(err) {
+					panic(err)
+				}
+				panic(conversionMessage(err, "function wants return type "+rt.Out(0).String()+" but received type "+rv.Type().String()))
 			}
 			return []reflect.Value{rv}
 		}
@@ -199,9 +312,12 @@ func convertVMFunctionToType(rv reflect.Value, rt reflect.Type) (reflect.Value,
 		// try to covert each value in slice to wanted type and put into a reflect.Value slice
 		rvs = make([]reflect.Value, rt.NumOut())
 		for i := 0; i < rv.Len(); i++ {
-			rvs[i], err = convertReflectValueToType(rv.Index(i), rt.Out(i))
+			rvs[i], err = convertReflectValueToType(ctx, rv.Index(i), rt.Out(i))
 			if err != nil {
-				panic("function wants return type " + rt.Out(i).String() + " but received type " + rvs[i].Type().String())
+				if isCallError(err) {
+					panic(err)
+				}
+				panic(conversionMessage(err, "function wants return type "+rt.Out(i).String()+" but received type "+rvs[i].Type().String()))
 			}
 		}
 
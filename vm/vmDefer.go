@@ -0,0 +1,157 @@
+package vm
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/over"
+)
+
+// deferKey is the context key of the deferState of the deferred calls being run.
+type deferKey struct{}
+
+// deferState holds the error of a function while its deferred calls run.
+// recover returns it and clears it, a deferred call that fails replaces it.
+type deferState struct {
+	err error
+}
+
+// deferGracePeriod is the default of Options.DeferGracePeriod.
+const deferGracePeriod = time.Second
+
+// detachedContext is the parent of the context of the deferred calls of an interrupted function.
+// It keeps the values of the parent context, but it is never done.
+type detachedContext struct {
+	context.Context
+}
+
+// detachedKey is the context key that marks the contexts of the deferred calls of an interrupted function,
+// so the deferred calls of the functions they call do not get another grace period.
+type detachedKey struct{}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// deferCall adds the call of f with args to the deferred calls of the function being run.
+// Like in Go, f and args are evaluated when the defer statement runs.
+func (runInfo *runInfoStruct) deferCall(f reflect.Value, args []reflect.Value, useCallSlice bool, isRunVMFunction bool) {
+	runInfo.defers = append(runInfo.defers, func(ctx context.Context) error {
+		if isRunVMFunction {
+			args[0] = reflect.ValueOf(ctx)
+		}
+		var rvs []reflect.Value
+		if useCallSlice {
+			rvs = f.CallSlice(args)
+		} else {
+			rvs = f.Call(args)
+		}
+		// like in Go, values returned by deferred calls are discarded
+		_, err := processCallReturnValues(rvs, isRunVMFunction, true)
+		return err
+	})
+}
+
+// deferCallable adds the call of c with args to the deferred calls of the function being run.
+func (runInfo *runInfoStruct) deferCallable(callExpr *ast.CallExpr, c over.Callable, args []interface{}) {
+	runInfo.defers = append(runInfo.defers, func(ctx context.Context) error {
+		// c can not be interrupted, so it is not called once the deferred calls are
+		if ctx.Err() != nil {
+			return ErrInterrupt
+		}
+		_, err := c.Call(args...)
+		if err != nil {
+			return newError(callExpr, err)
+		}
+		return nil
+	})
+}
+
+// runDefers runs the deferred calls of the function being run in reverse order.
+// Deferred calls run even if the function failed or was interrupted,
+// in the latter case with a context that is done after the grace period of the options.
+// recover in a deferred call clears the error, except ErrInterrupt, and a failing deferred call replaces it.
+func (runInfo *runInfoStruct) runDefers() {
+	if len(runInfo.defers) == 0 {
+		return
+	}
+	defers := runInfo.defers
+	runInfo.defers = nil
+
+	ctx := runInfo.ctx
+	if ctx.Err() != nil && ctx.Value(detachedKey{}) == nil {
+		gracePeriod := runInfo.options.DeferGracePeriod
+		if gracePeriod == 0 {
+			gracePeriod = deferGracePeriod
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.WithValue(detachedContext{ctx}, detachedKey{}, true), gracePeriod)
+		defer cancel()
+	}
+	state := &deferState{}
+	if runInfo.err != ErrReturn {
+		state.err = runInfo.err
+	}
+	ctx = context.WithValue(ctx, deferKey{}, state)
+
+	rv, err := runInfo.rv, runInfo.err
+	for i := len(defers) - 1; i >= 0; i-- {
+		runInfo.err = nil
+		runInfo.callDeferred(ctx, defers[i])
+		if runInfo.err != nil {
+			state.err = runInfo.err
+		}
+	}
+
+	switch {
+	case err == ErrInterrupt:
+		runInfo.rv, runInfo.err = nilValue, ErrInterrupt
+	case state.err != nil:
+		runInfo.rv, runInfo.err = nilValue, state.err
+	case err != nil && err != ErrReturn:
+		// recovered
+		runInfo.rv, runInfo.err = nilValue, nil
+	default:
+		runInfo.rv, runInfo.err = rv, err
+	}
+}
+
+func (runInfo *runInfoStruct) callDeferred(ctx context.Context, call func(ctx context.Context) error) {
+	if !runInfo.options.Debug {
+		// captures panic
+		defer recoverFunc(runInfo)
+	}
+	runInfo.err = call(ctx)
+}
+
+// recoverCall runs the call of the recover builtin, used when no recover is defined.
+// recover returns the error of the function whose deferred calls are running and clears it.
+// It returns nil outside of deferred calls and for interrupted functions.
+// Like in Go, recover does nothing when it is the deferred or go call itself.
+func (runInfo *runInfoStruct) recoverCall(callExpr *ast.CallExpr) {
+	runInfo.rv = nilValue
+	runInfo.err = nil
+	if len(callExpr.SubExprs) > 0 {
+		runInfo.err = newStringError(callExpr, "too many arguments to recover")
+		return
+	}
+	if callExpr.Defer || callExpr.Go {
+		return
+	}
+	state, ok := runInfo.ctx.Value(deferKey{}).(*deferState)
+	if !ok || state.err == nil || state.err == ErrInterrupt {
+		return
+	}
+	runInfo.rv = reflect.ValueOf(state.err)
+	state.err = nil
+}
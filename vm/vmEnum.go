@@ -0,0 +1,80 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// vmEnum is the value of script enum declarations.
+// It is indexed by ordinal and iterated in declaration order over its members.
+type vmEnum struct {
+	name    string
+	members []*vmEnumValue
+}
+
+// vmEnumValue is a member of a script enum.
+// Members are unique pointers, so they compare equal only to themselves.
+type vmEnumValue struct {
+	enum    *vmEnum
+	name    string
+	ordinal int64
+}
+
+// newEnum returns the enum named name with the members names, in order.
+func newEnum(name string, names []string) *vmEnum {
+	enum := &vmEnum{name: name, members: make([]*vmEnumValue, len(names))}
+	for i, member := range names {
+		enum.members[i] = &vmEnumValue{enum: enum, name: member, ordinal: int64(i)}
+	}
+	return enum
+}
+
+func (e *vmEnum) String() string {
+	return e.name
+}
+
+// Len returns the number of members of the enum.
+func (e *vmEnum) Len() int64 {
+	return int64(len(e.members))
+}
+
+// Index returns the member with the ordinal i.
+func (e *vmEnum) Index(i interface{}) (interface{}, error) {
+	ordinal, err := tryToInt64(reflect.ValueOf(i))
+	if err != nil {
+		return nil, fmt.Errorf("enum %v index must be a number", e.name)
+	}
+	if ordinal < 0 || ordinal >= e.Len() {
+		return nil, errors.New("index out of range")
+	}
+	return e.members[ordinal], nil
+}
+
+// member returns the member named name, or nil if the enum does not have it.
+func (e *vmEnum) member(name string) *vmEnumValue {
+	for _, member := range e.members {
+		if member.name == name {
+			return member
+		}
+	}
+	return nil
+}
+
+// has returns true if v is a member of the enum.
+func (e *vmEnum) has(v reflect.Value) bool {
+	if !v.IsValid() || !v.CanInterface() {
+		return false
+	}
+	member, ok := v.Interface().(*vmEnumValue)
+	return ok && member != nil && member.enum == e
+}
+
+func (v vmEnumValue) String() string {
+	return v.name
+}
+
+// Ordinal returns the position of the member in its enum declaration, starting at 0.
+func (v vmEnumValue) Ordinal() int64 {
+	return v.ordinal
+}
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/dgrr/pako/ast"
 	"github.com/dgrr/pako/env"
@@ -28,17 +29,48 @@ func (runInfo *runInfoStruct) invokeExpr() {
 	case *ast.LiteralExpr:
 		runInfo.rv = expr.Literal
 
+	// InterpolatedStringExpr
+	case *ast.InterpolatedStringExpr:
+		var sb strings.Builder
+		sb.WriteString(expr.Strings[0])
+		var i int
+		for i, runInfo.expr = range expr.Exprs {
+			runInfo.invokeExpr()
+			if runInfo.err != nil {
+				return
+			}
+			s, err := toInterpolatedString(runInfo.ctx, runInfo.rv)
+			if err != nil {
+				runInfo.err = err
+				runInfo.rv = nilValue
+				return
+			}
+			sb.WriteString(s)
+			sb.WriteString(expr.Strings[i+1])
+		}
+		runInfo.rv = reflect.ValueOf(sb.String())
+
 	// ArrayExpr
 	case *ast.ArrayExpr:
 		if expr.TypeData == nil {
-			slice := make([]interface{}, len(expr.Exprs))
-			var i int
-			for i, runInfo.expr = range expr.Exprs {
+			slice := make([]interface{}, 0, len(expr.Exprs))
+			for _, item := range expr.Exprs {
+				if spread, ok := item.(*ast.SpreadExpr); ok {
+					values := runInfo.spreadSlice(spread)
+					if runInfo.err != nil {
+						return
+					}
+					for _, value := range values {
+						slice = append(slice, value.Interface())
+					}
+					continue
+				}
+				runInfo.expr = item
 				runInfo.invokeExpr()
 				if runInfo.err != nil {
 					return
 				}
-				slice[i] = runInfo.rv.Interface()
+				slice = append(slice, runInfo.rv.Interface())
 			}
 			runInfo.rv = reflect.ValueOf(slice)
 			return
@@ -55,33 +87,90 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
-		slice := reflect.MakeSlice(t, len(expr.Exprs), len(expr.Exprs))
-		var i int
+		slice := reflect.MakeSlice(t, 0, len(expr.Exprs))
 		valueType := t.Elem()
-		for i, runInfo.expr = range expr.Exprs {
+		appendValue := func(value reflect.Value) bool {
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, valueType)
+			if runInfo.err != nil {
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "cannot use type "+value.Type().String()+" as type "+valueType.String()+" as slice value"))
+				}
+				runInfo.rv = nilValue
+				return false
+			}
+			slice = reflect.Append(slice, value)
+			return true
+		}
+		for _, item := range expr.Exprs {
+			if spread, ok := item.(*ast.SpreadExpr); ok {
+				values := runInfo.spreadSlice(spread)
+				if runInfo.err != nil {
+					return
+				}
+				for _, value := range values {
+					if !appendValue(value) {
+						return
+					}
+				}
+				continue
+			}
+			runInfo.expr = item
 			runInfo.invokeExpr()
 			if runInfo.err != nil {
 				return
 			}
-
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
-			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as slice value")
-				runInfo.rv = nilValue
+			if !appendValue(runInfo.rv) {
 				return
 			}
-
-			slice.Index(i).Set(runInfo.rv)
 		}
 		runInfo.rv = slice
 
+	// StructExpr
+	case *ast.StructExpr:
+		runInfo.invokeStructExpr(expr)
+
 	// MapExpr
 	case *ast.MapExpr:
 		if expr.TypeData == nil {
 			var i int
 			var key reflect.Value
+			var hm *HashMap
 			m := make(map[interface{}]interface{}, len(expr.Keys))
+			putValue := func(key reflect.Value, value reflect.Value) bool {
+				if hm == nil && isHashKey(key) {
+					hm, runInfo.err = newHashMapFrom(runInfo.ctx, reflect.ValueOf(m))
+					if runInfo.err != nil {
+						runInfo.err = newError(expr, runInfo.err)
+						runInfo.rv = nilValue
+						return false
+					}
+				}
+				if hm != nil {
+					runInfo.err = hm.putContext(runInfo.ctx, key.Interface(), value.Interface())
+					if runInfo.err != nil {
+						runInfo.err = newError(expr, runInfo.err)
+						runInfo.rv = nilValue
+						return false
+					}
+					return true
+				}
+				m[key.Interface()] = value.Interface()
+				return true
+			}
 			for i, runInfo.expr = range expr.Keys {
+				if spread, ok := runInfo.expr.(*ast.SpreadExpr); ok {
+					keys, values := runInfo.spreadMap(spread)
+					if runInfo.err != nil {
+						return
+					}
+					for j := range keys {
+						if !putValue(keys[j], values[j]) {
+							return
+						}
+					}
+					continue
+				}
+
 				runInfo.invokeExpr()
 				if runInfo.err != nil {
 					return
@@ -94,7 +183,13 @@ func (runInfo *runInfoStruct) invokeExpr() {
 					return
 				}
 
-				m[key.Interface()] = runInfo.rv.Interface()
+				if !putValue(key, runInfo.rv) {
+					return
+				}
+			}
+			if hm != nil {
+				runInfo.rv = reflect.ValueOf(hm)
+				return
 			}
 			runInfo.rv = reflect.ValueOf(m)
 			return
@@ -122,31 +217,54 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		m := runInfo.rv
 		keyType := t.Key()
 		valueType := t.Elem()
-		for i, runInfo.expr = range expr.Keys {
-			runInfo.invokeExpr()
+		setValue := func(key reflect.Value, value reflect.Value) bool {
+			key, runInfo.err = convertReflectValueToType(runInfo.ctx, key, keyType)
 			if runInfo.err != nil {
-				return
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "cannot use type "+key.Type().String()+" as type "+keyType.String()+" as map key"))
+				}
+				runInfo.rv = nilValue
+				return false
 			}
-			key, runInfo.err = convertReflectValueToType(runInfo.rv, keyType)
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, valueType)
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "cannot use type "+key.Type().String()+" as type "+keyType.String()+" as map key")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "cannot use type "+value.Type().String()+" as type "+valueType.String()+" as map value"))
+				}
 				runInfo.rv = nilValue
+				return false
+			}
+			m.SetMapIndex(key, value)
+			return true
+		}
+		for i, runInfo.expr = range expr.Keys {
+			if spread, ok := runInfo.expr.(*ast.SpreadExpr); ok {
+				keys, values := runInfo.spreadMap(spread)
+				if runInfo.err != nil {
+					return
+				}
+				for j := range keys {
+					if !setValue(keys[j], values[j]) {
+						return
+					}
+				}
+				continue
+			}
+
+			runInfo.invokeExpr()
+			if runInfo.err != nil {
 				return
 			}
+			key = runInfo.rv
 
 			runInfo.expr = expr.Values[i]
 			runInfo.invokeExpr()
 			if runInfo.err != nil {
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, valueType)
-			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "cannot use type "+runInfo.rv.Type().String()+" as type "+valueType.String()+" as map value")
-				runInfo.rv = nilValue
+			if !setValue(key, runInfo.rv) {
 				return
 			}
-
-			m.SetMapIndex(key, runInfo.rv)
 		}
 		runInfo.rv = m
 
@@ -188,6 +306,17 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		v := runInfo.rv
+		if v.Kind() == reflect.Interface && !v.IsNil() {
+			v = v.Elem()
+		}
+		if name, ok := unaryMethods[expr.Operator]; ok && runInfo.invokeOperatorMethod(v, name) {
+			return
+		}
+		if runInfo.invokeUnary(expr, v) {
+			return
+		}
+
 		switch expr.Operator {
 		case "-":
 			switch runInfo.rv.Kind() {
@@ -241,6 +370,16 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			}
 			return
 		}
+		if enum, ok := runInfo.rv.Interface().(*vmEnum); ok {
+			member := enum.member(expr.Name)
+			if member == nil {
+				runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for enum "+enum.name)
+				runInfo.rv = nilValue
+				return
+			}
+			runInfo.rv = reflect.ValueOf(member)
+			return
+		}
 		runInfo.recv = runInfo.rv
 
 		if v, ok := runInfo.rv.Interface().(*vmStruct); ok {
@@ -259,7 +398,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 
 		switch runInfo.rv.Kind() {
 		case reflect.Struct:
-			field, found := runInfo.rv.Type().FieldByName(expr.Name)
+			field, found := fieldByName(runInfo.rv.Type(), expr.Name)
 			if found {
 				runInfo.rv = runInfo.rv.FieldByIndex(field.Index)
 				return
@@ -272,11 +411,20 @@ func (runInfo *runInfoStruct) invokeExpr() {
 					return
 				}
 			}
+			if runInfo.invokeGetter(expr, runInfo.recv) {
+				return
+			}
 			runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for struct")
 			runInfo.rv = nilValue
 		case reflect.Map:
-			runInfo.rv = getMapIndex(reflect.ValueOf(expr.Name), runInfo.rv)
+			if runInfo.invokeGetter(expr, runInfo.recv) {
+				return
+			}
+			runInfo.rv = getMapIndex(runInfo.ctx, reflect.ValueOf(expr.Name), runInfo.rv)
 		default:
+			if runInfo.invokeGetter(expr, runInfo.recv) {
+				return
+			}
 			runInfo.err = newStringError(expr, "type "+runInfo.rv.Kind().String()+" does not support member operation")
 			runInfo.rv = nilValue
 		}
@@ -300,6 +448,26 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			item = item.Elem()
 		}
 
+		if runInfo.invokeOperatorMethod(item, "__index__", runInfo.rv) {
+			return
+		}
+
+		if item.Type() == hashMapType {
+			var value interface{}
+			value, _, runInfo.err = item.Interface().(*HashMap).getContext(runInfo.ctx, getUnderlyingType(runInfo.rv))
+			if runInfo.err != nil {
+				runInfo.err = newError(expr, runInfo.err)
+				runInfo.rv = nilValue
+				return
+			}
+			if value == nil {
+				runInfo.rv = nilValue
+			} else {
+				runInfo.rv = reflect.ValueOf(value)
+			}
+			return
+		}
+
 		if item.Type().Implements(over.IndexReflectType) {
 			v := item.Interface().(over.Index)
 			vi := getUnderlyingType(runInfo.rv)
@@ -333,7 +501,7 @@ func (runInfo *runInfoStruct) invokeExpr() {
 				runInfo.rv = item.Index(index).Convert(stringType)
 			}
 		case reflect.Map:
-			runInfo.rv = getMapIndex(runInfo.rv, item)
+			runInfo.rv = getMapIndex(runInfo.ctx, runInfo.rv, item)
 		default:
 			runInfo.err = newStringError(expr, "type "+item.Kind().String()+" does not support index operation")
 			runInfo.rv = nilValue
@@ -506,6 +674,13 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if runInfo.invokeOperatorMethod(runInfo.rv, "__len__") {
+			if runInfo.err == nil {
+				runInfo.rv = reflect.ValueOf(toInt64(runInfo.rv))
+			}
+			return
+		}
+
 		if runInfo.rv.Type().Implements(over.LenReflectType) {
 			v := runInfo.rv.Interface().(over.Len)
 			runInfo.rv = reflect.ValueOf(v.Len())
@@ -575,9 +750,15 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		}
 
 		runInfo.rv, runInfo.err = makeValue(runInfo, expr.TypeData.Name, t)
-		if runInfo.err == nil &&
-			(runInfo.rv.Kind() == reflect.Struct ||
-				(runInfo.rv.Kind() == reflect.Ptr && runInfo.rv.Elem().Kind() == reflect.Struct)) {
+		if runInfo.err == nil {
+			runInfo.err = runInfo.setDefaults(runInfo.typeDefaults(expr.TypeData), runInfo.rv)
+		}
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+		if runInfo.rv.Kind() == reflect.Struct ||
+			(runInfo.rv.Kind() == reflect.Ptr && runInfo.rv.Elem().Kind() == reflect.Struct) {
 			var vc reflect.Value
 			if runInfo.rv.Kind() == reflect.Ptr {
 				vc = runInfo.rv.Elem().FieldByName("New")
@@ -688,9 +869,11 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		// chan lhs <- rhs is send
 
 		runInfo.rv = nilValue
-		rhs, runInfo.err = convertReflectValueToType(rhs, lhs.Type().Elem())
+		rhs, runInfo.err = convertReflectValueToType(runInfo.ctx, rhs, lhs.Type().Elem())
 		if runInfo.err != nil {
-			runInfo.err = newStringError(expr, "cannot use type "+rhs.Type().String()+" as type "+lhs.Type().Elem().String()+" to send to chan")
+			if !isCallError(runInfo.err) {
+				runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "cannot use type "+rhs.Type().String()+" as type "+lhs.Type().Elem().String()+" to send to chan"))
+			}
 			return
 		}
 		// send rhs to lhs channel
@@ -736,6 +919,29 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		runInfo.expr = expr
 		runInfo.callExpr()
 
+	// IsExpr
+	case *ast.IsExpr:
+		runInfo.expr = expr.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		value := runInfo.rv
+		i, t := runInfo.assertType(expr, expr.Type)
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+		runInfo.rv = reflect.ValueOf(isType(value, i, t))
+
+	// TypeAssertExpr
+	case *ast.TypeAssertExpr:
+		runInfo.typeAssert(expr)
+
+	// MatchExpr
+	case *ast.MatchExpr:
+		runInfo.invokeMatch(expr)
+
 	// IncludeExpr
 	case *ast.IncludeExpr:
 		runInfo.expr = expr.ItemExpr
@@ -751,6 +957,31 @@ func (runInfo *runInfoStruct) invokeExpr() {
 			return
 		}
 
+		if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+			runInfo.rv = runInfo.rv.Elem()
+		}
+		if runInfo.invokeOperatorMethod(runInfo.rv, "__contains__", itemExpr) {
+			if runInfo.err == nil {
+				runInfo.rv = reflect.ValueOf(toBool(runInfo.rv))
+			}
+			return
+		}
+		if runInfo.rv.IsValid() && runInfo.rv.Type().Implements(over.ContainsReflectType) {
+			var found bool
+			if hm, ok := runInfo.rv.Interface().(*HashMap); ok {
+				_, found, runInfo.err = hm.getContext(runInfo.ctx, getUnderlyingType(itemExpr))
+			} else {
+				found, runInfo.err = runInfo.rv.Interface().(over.Contains).Contains(getUnderlyingType(itemExpr))
+			}
+			if runInfo.err != nil {
+				runInfo.err = newError(expr, runInfo.err)
+				runInfo.rv = nilValue
+				return
+			}
+			runInfo.rv = reflect.ValueOf(found)
+			return
+		}
+
 		if runInfo.rv.Kind() != reflect.Slice && runInfo.rv.Kind() != reflect.Array {
 			runInfo.err = newStringError(expr, "second argument must be slice or array; but have "+runInfo.rv.Kind().String())
 			runInfo.rv = nilValue
@@ -758,7 +989,13 @@ func (runInfo *runInfoStruct) invokeExpr() {
 		}
 
 		for i := 0; i < runInfo.rv.Len(); i++ {
-			if equal(itemExpr, runInfo.rv.Index(i)) {
+			found, err := equal(runInfo.ctx, itemExpr, runInfo.rv.Index(i))
+			if err != nil {
+				runInfo.err = err
+				runInfo.rv = nilValue
+				return
+			}
+			if found {
 				runInfo.rv = trueValue
 				return
 			}
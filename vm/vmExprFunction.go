@@ -6,6 +6,7 @@ import (
 	"reflect"
 
 	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/over"
 )
 
 func (runInfo *runInfoStruct) funcType(params int, styp reflect.Type, hasRecv, varArg bool) reflect.Type {
@@ -78,8 +79,24 @@ func (runInfo *runInfoStruct) funcExpr() reflect.Type {
 			}
 		}
 
+		if funcExpr.Generator {
+			// the function statements are run by the generator
+			gen := newGenerator(runInfo.ctx, func(ctx context.Context, gen *Generator) error {
+				runInfo.ctx = ctx
+				runInfo.gen = gen
+				runInfo.runSingleStmt()
+				runInfo.runDefers()
+				if runInfo.err != nil && runInfo.err != ErrReturn {
+					return newError(funcExpr, runInfo.err)
+				}
+				return nil
+			})
+			return []reflect.Value{reflect.ValueOf(reflect.ValueOf(gen)), reflectValueErrorNilValue}
+		}
+
 		// run function statements
 		runInfo.runSingleStmt()
+		runInfo.runDefers()
 		if runInfo.err != nil && runInfo.err != ErrReturn {
 			runInfo.err = newError(funcExpr, runInfo.err)
 			// return nil value and error
@@ -129,7 +146,7 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 	if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
 		runInfo.rv = runInfo.rv.Elem()
 	}
-	if runInfo.rv.Kind() != reflect.Func {
+	if runInfo.rv.Kind() != reflect.Func && !runInfo.rv.Type().Implements(over.CallableReflectType) {
 		runInfo.err = newStringError(anonCallExpr, "cannot call type "+runInfo.rv.Kind().String())
 		runInfo.rv = nilValue
 		return
@@ -140,6 +157,7 @@ func (runInfo *runInfoStruct) anonCallExpr() {
 		SubExprs: anonCallExpr.SubExprs,
 		VarArg:   anonCallExpr.VarArg,
 		Go:       anonCallExpr.Go,
+		Defer:    anonCallExpr.Defer,
 	}
 	expr.SetPosition(anonCallExpr.Position())
 	runInfo.expr = expr
@@ -199,6 +217,10 @@ func (runInfo *runInfoStruct) callExpr() {
 	if !f.IsValid() {
 		// if function is not valid try to get by function name
 		f, runInfo.err = runInfo.env.GetValue(callExpr.Name)
+		if runInfo.err != nil && callExpr.Name == "recover" {
+			runInfo.recoverCall(callExpr)
+			return
+		}
 		if runInfo.err != nil {
 			runInfo.err = newError(callExpr, runInfo.err)
 			runInfo.rv = nilValue
@@ -209,6 +231,10 @@ func (runInfo *runInfoStruct) callExpr() {
 	if f.Kind() == reflect.Interface && !f.IsNil() {
 		f = f.Elem()
 	}
+	if f.Kind() != reflect.Func && f.Type().Implements(over.CallableReflectType) {
+		runInfo.invokeCallable(callExpr, f.Interface().(over.Callable))
+		return
+	}
 	if f.Kind() != reflect.Func {
 		runInfo.err = newStringError(callExpr, "cannot call type "+f.Kind().String())
 		runInfo.rv = nilValue
@@ -227,6 +253,12 @@ func (runInfo *runInfoStruct) callExpr() {
 		return
 	}
 
+	if callExpr.Defer {
+		runInfo.deferCall(f, args, useCallSlice, isRunVMFunction)
+		runInfo.rv = nilValue
+		return
+	}
+
 	if !runInfo.options.Debug {
 		// captures panic
 		defer recoverFunc(runInfo)
@@ -282,6 +314,49 @@ func (runInfo *runInfoStruct) callExpr() {
 	runInfo.rv, runInfo.err = processCallReturnValues(rvs, isRunVMFunction, true)
 }
 
+// invokeCallable calls the over.Callable c with the arguments of callExpr.
+// Errors returned by Call are run errors.
+func (runInfo *runInfoStruct) invokeCallable(callExpr *ast.CallExpr, c over.Callable) {
+	args := make([]interface{}, 0, len(callExpr.SubExprs))
+	for i, expr := range callExpr.SubExprs {
+		runInfo.expr = expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+			runInfo.rv = runInfo.rv.Elem()
+		}
+		if callExpr.VarArg && i == len(callExpr.SubExprs)-1 && (runInfo.rv.Kind() == reflect.Slice || runInfo.rv.Kind() == reflect.Array) {
+			for j := 0; j < runInfo.rv.Len(); j++ {
+				args = append(args, runInfo.rv.Index(j).Interface())
+			}
+			continue
+		}
+		args = append(args, runInfo.rv.Interface())
+	}
+
+	runInfo.rv = nilValue
+	if callExpr.Go {
+		go c.Call(args...)
+		return
+	}
+	if callExpr.Defer {
+		runInfo.deferCallable(callExpr, c, args)
+		return
+	}
+
+	value, err := c.Call(args...)
+	if err != nil {
+		runInfo.err = newError(callExpr, err)
+		return
+	}
+	runInfo.rv = reflect.ValueOf(value)
+	if !runInfo.rv.IsValid() {
+		runInfo.rv = nilValue
+	}
+}
+
 // checkIfRunVMFunction checking the number and types of the reflect.Type.
 // If it matches the types for a runVMFunction this will return true, otherwise false
 func checkIfRunVMFunction(rt reflect.Type) bool {
@@ -390,10 +465,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
-				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+						conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+				}
 				runInfo.rv = nilValue
 				return nil, false
 			}
@@ -418,10 +495,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
-				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+						conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+				}
 				runInfo.rv = nilValue
 				return nil, false
 			}
@@ -453,10 +532,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if isRunVMFunction {
 				args = append(args, reflect.ValueOf(runInfo.rv.Index(indexSlice)))
 			} else {
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv.Index(indexSlice), rt.In(indexInReal))
+				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv.Index(indexSlice), rt.In(indexInReal))
 				if runInfo.err != nil {
-					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-						"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+					if !isCallError(runInfo.err) {
+						runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+							conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+					}
 					runInfo.rv = nilValue
 					return nil, false
 				}
@@ -487,10 +568,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 		if isRunVMFunction {
 			args = append(args, reflect.ValueOf(runInfo.rv))
 		} else {
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, rt.In(indexInReal))
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, rt.In(indexInReal))
 			if runInfo.err != nil {
-				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+						conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+				}
 				runInfo.rv = nilValue
 				return nil, false
 			}
@@ -508,10 +591,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 			if runInfo.err != nil {
 				return nil, false
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, sliceType)
 			if runInfo.err != nil {
-				runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-					"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+						conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+				}
 				runInfo.rv = nilValue
 				return nil, false
 			}
@@ -533,10 +618,12 @@ func (runInfo *runInfoStruct) makeCallArgs(rt reflect.Type, isRunVMFunction bool
 	if runInfo.err != nil {
 		return nil, false
 	}
-	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, sliceType)
+	runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, sliceType)
 	if runInfo.err != nil {
-		runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
-			"function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String())
+		if !isCallError(runInfo.err) {
+			runInfo.err = newStringError(callExpr.SubExprs[indexExpr],
+				conversionMessage(runInfo.err, "function wants argument type "+rt.In(indexInReal).String()+" but received type "+runInfo.rv.Type().String()))
+		}
 		runInfo.rv = nilValue
 		return nil, false
 	}
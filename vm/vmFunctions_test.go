@@ -2,8 +2,10 @@ package vm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -861,3 +863,307 @@ waitGroup.Wait()`,
 
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestGenerators(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `yield 1`, ParseError: fmt.Errorf("yield outside of function")},
+		{Script: `fn f() { fn() { yield 1 } }; yield 2`, ParseError: fmt.Errorf("yield outside of function")},
+
+		{Script: `fn count(n) { for i = 0; i < n; i++ { yield i } }; s = []; for v in count(3) { s += v }; s`, RunOutput: []interface{}{int64(0), int64(1), int64(2)}},
+		{Script: `fn f() { yield 1; yield "a"; yield nil; return 2 }; s = []; for v in f() { s += v }; s`, RunOutput: []interface{}{int64(1), "a", nil}},
+		{Script: `fn f() { }; f()`, RunOutput: nil},
+		{Script: `fn f() { return; yield 1 }; s = []; for v in f() { s += v }; s`, RunOutput: []interface{}{}},
+		{Script: `fn f() { i = 0; for { yield i; i++ } }; s = 0; for k, v in f() { if v == 4 { break }; s += k + v }; s`, RunOutput: int64(12)},
+		{Script: `fn f() { i = 0; for { yield i; i++ } }; fn g() { for v in f() { if v == 3 { return v } } }; g()`, RunOutput: int64(3)},
+		{Script: `fn f() { for v in [1, 2] { yield fn() { yield v * 10 } } }; s = []; for g in f() { for v in g() { s += v } }; s`, RunOutput: []interface{}{int64(10), int64(20)}},
+		{Script: `fn f(a...) { for v in a { yield v } }; s = []; for v in f(1, 2) { s += v }; s`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `fn f() { yield 1; throw "bad" }; s = []; for v in f() { s += v }`, RunError: fmt.Errorf("bad")},
+		{Script: `fn f() { yield 1; throw "bad" }; s = []; try { for v in f() { s += v } } catch e { s += "caught" }; s`, RunOutput: []interface{}{int64(1), "caught"}},
+		{Script: `fn f() { try { yield 1 } catch { } finally { yield 2 } }; s = []; for v in f() { s += v }; s`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `fn f() { yield 1 }; g = f(); [g.Next(), g.Value(), g.Next(), g.Next()]`, RunOutput: []interface{}{true, int64(1), false, false}},
+		{Script: `fn f() { for { yield 1 } }; g = f(); g.Next(); g.Close(); [g.Next(), g.Err()]`, RunOutput: []interface{}{false, nil}},
+		{Script: `a = 0; fn f() { a = 1; yield 1 }; g = f(); b = a; g.Next(); [b, a]`, RunOutput: []interface{}{int64(0), int64(1)}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestGeneratorCall(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	_, err := Execute(e, nil, `fn count() { i = 0; for { yield i; i++ } }`)
+	if err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	rv, err := Call(context.Background(), e, "count")
+	if err != nil {
+		t.Fatalf("Call error: %v", err)
+	}
+	gen, ok := rv.(*Generator)
+	if !ok {
+		t.Fatalf("Call result type - received: %T - expected: *Generator", rv)
+	}
+	for i := int64(0); i < 3; i++ {
+		if !gen.Next() {
+			t.Fatalf("Next returned false - err: %v", gen.Err())
+		}
+		if gen.Value() != i {
+			t.Errorf("Value - received: %v - expected: %v", gen.Value(), i)
+		}
+	}
+	if err := gen.Close(); err != nil {
+		t.Errorf("Close error: %v", err)
+	}
+	if gen.Next() {
+		t.Errorf("Next after Close returned true")
+	}
+}
+
+func TestGeneratorStoppedByRun(t *testing.T) {
+	t.Parallel()
+
+	rv, err := Execute(env.NewEnv(), nil, `fn f() { for { yield 1 } }; g = f(); g.Next(); g`)
+	if err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	gen, ok := rv.(*Generator)
+	if !ok {
+		t.Fatalf("Execute result type - received: %T - expected: *Generator", rv)
+	}
+	if gen.Next() {
+		t.Errorf("Next after the run returned true")
+	}
+	if gen.Err() != ErrInterrupt {
+		t.Errorf("Err - received: %v - expected: %v", gen.Err(), ErrInterrupt)
+	}
+
+	// a generator first resumed by a later run is stopped too, since its run has returned
+	e := env.NewEnv()
+	rv, err = Execute(e, nil, `fn f() { for { yield 1 } }; g = f()`)
+	if err != nil {
+		t.Fatalf("Execute error: %v", err)
+	}
+	gen = rv.(*Generator)
+	_, err = Execute(e, nil, `for v in g { }`)
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("Execute error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+	if gen.Err() != ErrInterrupt {
+		t.Errorf("Err - received: %v - expected: %v", gen.Err(), ErrInterrupt)
+	}
+}
+
+func TestArrowFunctions(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `(1, b) => b`, ParseError: fmt.Errorf("syntax error: arrow function parameters must be names")},
+		{Script: `(a.b) => 1`, ParseError: fmt.Errorf("syntax error: arrow function parameters must be names")},
+
+		{Script: `f = x => x * 2; f(3)`, RunOutput: int64(6)},
+		{Script: `f = (x) => x * 2; f(3)`, RunOutput: int64(6)},
+		{Script: `f = (a, b) => a + b; f(1, 2)`, RunOutput: int64(3)},
+		{Script: `f = () => 5; f()`, RunOutput: int64(5)},
+		{Script: `f = (a, b) => { c = a * b; return c + 1 }; f(2, 3)`, RunOutput: int64(7)},
+		{Script: `f = x => x > 1 ? "big" : "small"; [f(1), f(2)]`, RunOutput: []interface{}{"small", "big"}},
+		{Script: `add = x => y => x + y; add(1)(2)`, RunOutput: int64(3)},
+		{Script: `fn apply(f, v) { return f(v) }; apply(x => x + 1, 1)`, RunOutput: int64(2)},
+		{Script: `a = 1; f = () => a; a = 2; f()`, RunOutput: int64(2)},
+		{Script: `f = x => { yield x; yield x + 1 }; s = []; for v in f(1) { s += v }; s`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `f = x => ({"a": x}); f(1)`, RunOutput: map[interface{}]interface{}{"a": int64(1)}},
+		{Script: `f = x => {"a": x}`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `f = (a,
+	b) => a - b; f(3, 1)`, RunOutput: int64(2)},
+		{Script: `a = (1 + 2) * 3; f = (x) => (x + 1) * a; f(1)`, RunOutput: int64(18)},
+		{Script: `a = 1; if (a == 1) { a = 2 }; a`, RunOutput: int64(2)},
+		{Script: `a = 0; for v in [1, 2] { f = () => v; a += f() }; a`, RunOutput: int64(3)},
+		{Script: `s = 0; if ((x) => x == 1)(1) { s = 1 }; s`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestArrowFunctionsNestedParentheses(t *testing.T) {
+	t.Parallel()
+
+	// each ( is checked for a following => once, so deeply nested parentheses do not take quadratic time
+	n := 20000
+	script := strings.Repeat("(", n) + "1" + strings.Repeat(")", n) + " + " + strings.Repeat("(", n) + "(x) => x" + strings.Repeat(")", n) + "(2)"
+	start := time.Now()
+	value, err := Execute(env.NewEnv(), nil, script)
+	if err != nil || value != int64(3) {
+		t.Errorf("execute - received: %v, %v - expected: 3, <nil>", value, err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("execute took %v", elapsed)
+	}
+}
+
+func TestLoopClosures(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `fs = []; for v in [1, 2, 3] { fs += () => v }; s = []; for f in fs { s += f() }; s`, RunOutput: []interface{}{int64(1), int64(2), int64(3)}},
+		{Script: `fs = []; for k, v in {"a": 1} { fs += () => [k, v] }; fs[0]()`, RunOutput: []interface{}{"a", int64(1)}},
+		{Script: `fs = []; for i = 0; i < 3; i++ { fs += () => i }; s = []; for f in fs { s += f() }; s`, RunOutput: []interface{}{int64(0), int64(1), int64(2)}},
+		{Script: `fs = []; for i = 0; i < 3; i++ { fs += fn() { i += 10; return i } }; [fs[0](), fs[0](), fs[2]()]`, RunOutput: []interface{}{int64(10), int64(20), int64(12)}},
+		{Script: `fs = []; for i = 0; i < 4; i++ { if i % 2 == 0 { continue }; fs += () => i }; [fs[0](), fs[1]()]`, RunOutput: []interface{}{int64(1), int64(3)}},
+		{Script: `fn count(n) { for i = 0; i < n; i++ { yield i } }; fs = []; for v in count(2) { fs += () => v }; [fs[0](), fs[1]()]`, RunOutput: []interface{}{int64(0), int64(1)}},
+		{Script: `fs = []; for v in [1, 2] { w = v * 10; fs += () => [v, w] }; [fs[0](), fs[1]()]`, RunOutput: []interface{}{[]interface{}{int64(1), int64(20)}, []interface{}{int64(2), int64(20)}}},
+		{Script: `a = 0; for v in [1, 2] { a += v }; a`, RunOutput: int64(3)},
+
+		// only the loop variables are new every iteration, the other variables keep their values
+		{Script: `a = []; for i in [1, 2] { if i == 2 { a += x }; x = i }; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = []; n = 0; for k in {"a": 1, "b": 2} { if n == 1 { a += x }; x = k; n++ }; len(a)`, RunOutput: int64(1)},
+		{Script: `fn g() { yield 1; yield 2 }; a = []; for v in g() { if v == 2 { a += x }; x = v }; a`, RunOutput: []interface{}{int64(1)}},
+		{Script: `a = []; for i = 0; i < 3; i++ { if i > 0 { a += x }; x = i }; a`, RunOutput: []interface{}{int64(0), int64(1)}},
+		{Script: `a = []; i = 0; for i < 3 { if i > 0 { a += x }; x = i; i++ }; a`, RunOutput: []interface{}{int64(0), int64(1)}},
+		{Script: `a = []; i = 0; for { if i > 0 { a += x }; x = i; i++; if i > 2 { break } }; a`, RunOutput: []interface{}{int64(0), int64(1)}},
+		{Script: `for i in [1, 2] { x = i }; x`, RunError: fmt.Errorf("undefined symbol 'x'")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestDefer(t *testing.T) {
+	t.Parallel()
+
+	push := `r = []; fn push(v) { r += v }; `
+	tests := []Test{
+		{Script: `defer 1`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `defer push`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: push + `fn f() { defer push(1); defer push(2); push(0) }; f(); r`, RunOutput: []interface{}{int64(0), int64(2), int64(1)}},
+		{Script: push + `fn f() { x = 1; defer push(x); x = 2; push(x) }; f(); r`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: push + `fn f() { for i = 0; i < 3; i++ { defer push(i) } }; f(); r`, RunOutput: []interface{}{int64(2), int64(1), int64(0)}},
+		{Script: push + `fn f() { defer fn() { push("anon") }(); push("body") }; f(); r`, RunOutput: []interface{}{"body", "anon"}},
+		{Script: push + `fn f() { defer push(1); return 3 }; [f(), r]`, RunOutput: []interface{}{int64(3), []interface{}{int64(1)}}},
+		{Script: push + `fn f() { if true { defer push(1) }; push(2) }; f(); r`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: push + `fn g() { defer push("g") }; fn f() { defer push("f"); g(); push("body") }; f(); r`, RunOutput: []interface{}{"g", "body", "f"}},
+		{Script: push + `fn f() { defer push(1); throw "boom" }; try { f() } catch { }; r`, RunOutput: []interface{}{int64(1)}},
+		{Script: push + `fn f() { defer push(1); throw "boom" }; f()`, RunError: fmt.Errorf("boom")},
+		{Script: push + `fn f() { defer push(1); fail()? }; try { f() } catch e { push(e.Error()) }; r`, Input: map[string]interface{}{"fail": func() (int64, error) { return 0, fmt.Errorf("failed") }}, RunOutput: []interface{}{int64(1), "failed"}},
+		{Script: `fn f() { defer b.WriteString("b"); b.WriteString("a") }; f(); b.String()`, Input: map[string]interface{}{"b": &bytes.Buffer{}}, RunOutput: "ab"},
+
+		{Script: `recover()`, RunOutput: nil},
+		{Script: `fn f() { defer fn() { e = recover() }(); return 1 }; e = "x"; [f(), e]`, RunOutput: []interface{}{int64(1), nil}},
+		{Script: `fn f() { defer fn() { e = recover() }(); throw "boom" }; e = nil; [f(), e.Error()]`, RunOutput: []interface{}{nil, "boom"}},
+		{Script: `fn f() { defer fn() { e = recover() }(); fail()? }; e = nil; f(); e.Error()`, Input: map[string]interface{}{"fail": func() (int64, error) { return 0, fmt.Errorf("failed") }}, RunOutput: "failed"},
+		{Script: `fn f() { defer fn() { recover(); e = recover() }(); throw "boom" }; e = 1; f(); e`, RunOutput: nil},
+		{Script: `fn f() { defer fn() { throw "replaced" }(); throw "boom" }; f()`, RunError: fmt.Errorf("replaced")},
+		{Script: `fn f() { defer fn() { throw "deferred" }(); return 1 }; f()`, RunError: fmt.Errorf("deferred")},
+		{Script: `fn f() { defer fn() { recover() }(); defer fn() { throw "replaced" }(); throw "boom" }; f()`, RunOutput: nil},
+		{Script: `fn f() { defer fn() { e = recover() }(); defer fn() { throw "replaced" }(); throw "boom" }; e = nil; f(); e.Error()`, RunOutput: "replaced"},
+		{Script: `fn f() { defer fn() { fn() { e = recover() }() }(); throw "boom" }; e = nil; f(); e.Error()`, RunOutput: "boom"},
+		{Script: `fn f() { defer recover(); throw "boom" }; f()`, RunError: fmt.Errorf("boom")},
+		{Script: `recover(1)`, RunError: fmt.Errorf("too many arguments to recover")},
+		{Script: `recover = 1; recover`, RunOutput: int64(1)},
+		{Script: `fn recover(e) { return e }; recover(2)`, RunOutput: int64(2)},
+		{Script: `fn f() { defer fn() { e = recover() }(); throw "boom" }; fn g() { recover = fn() { return "shadowed" }; return f() }; e = nil; g(); e.Error()`, RunOutput: "boom"},
+
+		{Script: push + `defer push(1); push(2); r`, RunOutput: []interface{}{int64(2)}},
+		{Script: `defer fn() { recover() }(); throw "boom"`, RunOutput: nil},
+		{Script: `defer fn() { throw "deferred" }(); 1`, RunError: fmt.Errorf("deferred")},
+
+		{Script: push + `fn f() { defer push("closed"); yield 1; yield 2 }; for v in f() { push(v); break }; r`, RunOutput: []interface{}{int64(1), "closed"}},
+		{Script: push + `fn f() { defer push("done"); yield 1 }; for v in f() { push(v) }; r`, RunOutput: []interface{}{int64(1), "done"}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestDeferInterrupt(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	_, err := ExecuteContext(ctx, e, nil, `
+ran = false
+recovered = 1
+fn f() {
+	defer fn() {
+		recovered = recover()
+		for i = 0; i < 3; i++ { }
+		ran = true
+	}()
+	for { }
+}
+f()
+`)
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("execute error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+	for name, expected := range map[string]interface{}{"ran": true, "recovered": nil} {
+		value, err := e.Get(name)
+		if err != nil {
+			t.Fatalf("Get error: %v", err)
+		}
+		if value != expected {
+			t.Errorf("%v - received: %v - expected: %v", name, value, expected)
+		}
+	}
+}
+
+func TestDeferInterruptGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	scripts := []string{
+		`fn f() { defer fn() { for { } }(); for { } }; f()`,
+		`fn g() { defer fn() { for { } }(); for { } }; fn f() { defer g(); for { } }; f()`,
+	}
+	for _, script := range scripts {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := ExecuteContext(ctx, env.NewEnv(), nil, script)
+			errChan <- err
+		}()
+		select {
+		case err := <-errChan:
+			if err == nil || err.Error() != ErrInterrupt.Error() {
+				t.Errorf("execute error - received: %v - expected: %v - script: %v", err, ErrInterrupt, script)
+			}
+		case <-time.After(5 * deferGracePeriod):
+			t.Errorf("deferred calls were not interrupted - script: %v", script)
+		}
+	}
+}
+
+func TestDeferGracePeriodOption(t *testing.T) {
+	t.Parallel()
+
+	options := &Options{DeferGracePeriod: 20 * time.Millisecond}
+	tests := []struct {
+		script string
+		ran    interface{}
+	}{
+		{script: `ran = false; fn f() { defer fn() { ran = true }(); for { } }; f()`, ran: true},
+		{script: `ran = false; fn f() { defer fn() { for { }; ran = true }(); for { } }; f()`, ran: false},
+	}
+	for _, test := range tests {
+		e := env.NewEnv()
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		errChan := make(chan error, 1)
+		go func() {
+			_, err := ExecuteContext(ctx, e, options, test.script)
+			errChan <- err
+		}()
+		select {
+		case err := <-errChan:
+			if err == nil || err.Error() != ErrInterrupt.Error() {
+				t.Errorf("execute error - received: %v - expected: %v - script: %v", err, ErrInterrupt, test.script)
+			}
+		case <-time.After(deferGracePeriod / 2):
+			t.Fatalf("deferred calls were not interrupted after the grace period - script: %v", test.script)
+		}
+		value, err := e.Get("ran")
+		if err != nil {
+			t.Fatalf("Get error: %v", err)
+		}
+		if value != test.ran {
+			t.Errorf("ran - received: %v - expected: %v - script: %v", value, test.ran, test.script)
+		}
+	}
+}
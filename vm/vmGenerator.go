@@ -0,0 +1,163 @@
+package vm
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// Generator is the over.Iterator returned by calling script functions with yield statements.
+// The function statements run in their own goroutine, that is suspended at every yield until the next value is requested.
+// for in loops close the generator when they end, and the generators created by a run and not closed
+// are stopped when the run returns, even if they are first resumed by a later run. A Generator is not safe for concurrent use.
+type Generator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	run     func(ctx context.Context, gen *Generator) error
+	values  chan reflect.Value
+	resume  chan struct{}
+	value   reflect.Value
+	err     error
+	started bool
+	done    bool
+}
+
+func newGenerator(ctx context.Context, run func(ctx context.Context, gen *Generator) error) *Generator {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Generator{
+		ctx:    ctx,
+		cancel: cancel,
+		run:    run,
+		values: make(chan reflect.Value),
+		resume: make(chan struct{}),
+		value:  nilValue,
+	}
+}
+
+// Next runs the function until its next yield statement.
+// It returns false when the function returns, fails or the generator is closed.
+func (g *Generator) Next() bool {
+	if g.done {
+		return false
+	}
+	if !g.started {
+		g.started = true
+		if running, ok := g.ctx.Value(runningGeneratorsKey{}).(*runningGenerators); ok && !running.add(g) {
+			// the run that created the generator has returned
+			g.cancel()
+		}
+		go g.loop()
+	} else {
+		select {
+		case g.resume <- struct{}{}:
+		case <-g.ctx.Done():
+		}
+	}
+
+	value, ok := <-g.values
+	if !ok {
+		g.done = true
+		g.value = nilValue
+		g.cancel()
+		return false
+	}
+	g.value = value
+	return true
+}
+
+// Value returns the last yielded value.
+func (g *Generator) Value() interface{} {
+	return g.value.Interface()
+}
+
+// Err returns the error of the function, if any.
+func (g *Generator) Err() error {
+	return g.err
+}
+
+// Close stops the function, waiting for it to return.
+func (g *Generator) Close() error {
+	if g.done {
+		return nil
+	}
+	g.cancel()
+	if g.started {
+		for range g.values {
+		}
+	}
+	g.done = true
+	g.value = nilValue
+	g.err = nil
+	return nil
+}
+
+func (g *Generator) loop() {
+	defer close(g.values)
+	if running, ok := g.ctx.Value(runningGeneratorsKey{}).(*runningGenerators); ok {
+		defer running.remove(g)
+	}
+	err := g.run(g.ctx, g)
+	if err != nil && g.ctx.Err() != nil {
+		err = ErrInterrupt
+	}
+	g.err = err
+}
+
+// yield sends value to the consumer, waiting until the next value is requested.
+func (g *Generator) yield(value reflect.Value) error {
+	select {
+	case g.values <- value:
+	case <-g.ctx.Done():
+		return ErrInterrupt
+	}
+	select {
+	case <-g.resume:
+		return nil
+	case <-g.ctx.Done():
+		return ErrInterrupt
+	}
+}
+
+// runningGeneratorsKey is the context key of the runningGenerators of a run.
+type runningGeneratorsKey struct{}
+
+// runningGenerators are the generators started by a run whose functions have not returned yet.
+type runningGenerators struct {
+	mutex      sync.Mutex
+	generators map[*Generator]struct{}
+	stopped    bool
+}
+
+// withRunningGenerators returns a copy of ctx that tracks the generators started with it.
+func withRunningGenerators(ctx context.Context) (context.Context, *runningGenerators) {
+	running := &runningGenerators{generators: make(map[*Generator]struct{})}
+	return context.WithValue(ctx, runningGeneratorsKey{}, running), running
+}
+
+// add tracks g until its function returns. It returns false if the run was stopped.
+func (r *runningGenerators) add(g *Generator) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.stopped {
+		return false
+	}
+	r.generators[g] = struct{}{}
+	return true
+}
+
+func (r *runningGenerators) remove(g *Generator) {
+	r.mutex.Lock()
+	delete(r.generators, g)
+	r.mutex.Unlock()
+}
+
+// stop cancels the contexts of the running generators, so their goroutines return,
+// and of the ones started later.
+func (r *runningGenerators) stop() {
+	r.mutex.Lock()
+	r.stopped = true
+	for g := range r.generators {
+		g.cancel()
+	}
+	r.mutex.Unlock()
+}
@@ -0,0 +1,263 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dgrr/pako/over"
+)
+
+// HashMap is the map the VM uses when keys implement over.Hasher or are script structs with a __hash__ method.
+// Those keys are compared with their Equals or __eq__ methods, other keys are stored in a Go map.
+// Map literals with such keys are HashMaps, and so are nil map[interface{}]interface{} values when such a key is assigned.
+// Other Go maps can not have such keys, as converting them would not change the other references to the map.
+type HashMap struct {
+	values  map[interface{}]interface{}
+	buckets map[uint64][]hashMapEntry
+	hashed  int64
+}
+
+type hashMapEntry struct {
+	key   interface{}
+	value interface{}
+}
+
+// NewHashMap returns an empty HashMap.
+func NewHashMap() *HashMap {
+	return &HashMap{
+		values:  make(map[interface{}]interface{}),
+		buckets: make(map[uint64][]hashMapEntry),
+	}
+}
+
+// isHashKey returns true if key has to be stored in a HashMap.
+func isHashKey(key reflect.Value) bool {
+	if key.Kind() == reflect.Interface && !key.IsNil() {
+		key = key.Elem()
+	}
+	if !key.IsValid() {
+		return false
+	}
+	if key.Type().Implements(over.HasherReflectType) {
+		return true
+	}
+	f, _ := operatorMethod(key, "__hash__")
+	return f.IsValid()
+}
+
+// hashKey returns the hash of key and true if key is stored in the buckets.
+func hashKey(ctx context.Context, key interface{}) (uint64, bool, error) {
+	if h, ok := key.(over.Hasher); ok {
+		return h.Hash(), true, nil
+	}
+	v := reflect.ValueOf(key)
+	f, recv := operatorMethod(v, "__hash__")
+	if !f.IsValid() {
+		return 0, false, nil
+	}
+	rv, err := callFunc(ctx, f, recv, nil)
+	if err != nil {
+		return 0, true, err
+	}
+	return uint64(toInt64(rv)), true, nil
+}
+
+// keysEqual returns true if the hashed keys a and b are equal.
+func keysEqual(ctx context.Context, a, b interface{}) (bool, error) {
+	if h, ok := a.(over.Hasher); ok {
+		return h.Equals(b) == nil, nil
+	}
+	return equal(ctx, reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// find returns the bucket of key and the index of key in it, or -1 if key is not in the map.
+// It fails if key is not hashed and cannot be a key of the Go map either.
+func (m *HashMap) find(ctx context.Context, key interface{}) (uint64, int, bool, error) {
+	hash, hashed, err := hashKey(ctx, key)
+	if err != nil {
+		return 0, -1, hashed, err
+	}
+	if !hashed {
+		if key != nil && !reflect.TypeOf(key).Comparable() {
+			return 0, -1, false, fmt.Errorf("cannot use unhashable type %T as map key", key)
+		}
+		return 0, -1, false, nil
+	}
+	for i, entry := range m.buckets[hash] {
+		eq, err := keysEqual(ctx, key, entry.key)
+		if err != nil {
+			return 0, -1, true, err
+		}
+		if eq {
+			return hash, i, true, nil
+		}
+	}
+	return hash, -1, true, nil
+}
+
+// Get returns the value of key and true if key is in the map.
+// The __hash__ and __eq__ methods of script structs are called with context.Background().
+func (m *HashMap) Get(key interface{}) (interface{}, bool, error) {
+	return m.getContext(context.Background(), key)
+}
+
+func (m *HashMap) getContext(ctx context.Context, key interface{}) (interface{}, bool, error) {
+	hash, i, hashed, err := m.find(ctx, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if !hashed {
+		value, ok := m.values[key]
+		return value, ok, nil
+	}
+	if i < 0 {
+		return nil, false, nil
+	}
+	return m.buckets[hash][i].value, true, nil
+}
+
+// Put sets the value of key.
+// The __hash__ and __eq__ methods of script structs are called with context.Background().
+func (m *HashMap) Put(key interface{}, value interface{}) error {
+	return m.putContext(context.Background(), key, value)
+}
+
+func (m *HashMap) putContext(ctx context.Context, key interface{}, value interface{}) error {
+	hash, i, hashed, err := m.find(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !hashed {
+		m.values[key] = value
+		return nil
+	}
+	if i >= 0 {
+		m.buckets[hash][i].value = value
+		return nil
+	}
+	m.buckets[hash] = append(m.buckets[hash], hashMapEntry{key: key, value: value})
+	m.hashed++
+	return nil
+}
+
+// Delete removes key from the map.
+// The __hash__ and __eq__ methods of script structs are called with context.Background().
+func (m *HashMap) Delete(key interface{}) error {
+	return m.deleteContext(context.Background(), key)
+}
+
+func (m *HashMap) deleteContext(ctx context.Context, key interface{}) error {
+	hash, i, hashed, err := m.find(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !hashed {
+		delete(m.values, key)
+		return nil
+	}
+	if i < 0 {
+		return nil
+	}
+	bucket := m.buckets[hash]
+	if len(bucket) == 1 {
+		delete(m.buckets, hash)
+	} else {
+		m.buckets[hash] = append(bucket[:i:i], bucket[i+1:]...)
+	}
+	m.hashed--
+	return nil
+}
+
+// Keys returns the keys of the map.
+func (m *HashMap) Keys() []interface{} {
+	keys := make([]interface{}, 0, m.Len())
+	for key := range m.values {
+		keys = append(keys, key)
+	}
+	for _, bucket := range m.buckets {
+		for _, entry := range bucket {
+			keys = append(keys, entry.key)
+		}
+	}
+	return keys
+}
+
+// Len returns the number of keys of the map.
+func (m *HashMap) Len() int64 {
+	return int64(len(m.values)) + m.hashed
+}
+
+// Index returns the value of key, or nil if key is not in the map.
+func (m *HashMap) Index(key interface{}) (interface{}, error) {
+	value, _, err := m.Get(key)
+	return value, err
+}
+
+// Contains returns true if key is in the map.
+func (m *HashMap) Contains(key interface{}) (bool, error) {
+	_, ok, err := m.Get(key)
+	return ok, err
+}
+
+func (m *HashMap) String() string {
+	var sb strings.Builder
+	sb.WriteString("map[")
+	for i, key := range m.Keys() {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		value, _, _ := m.Get(key)
+		fmt.Fprintf(&sb, "%v:%v", key, value)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// iterator returns an over.KeyIterator over the keys and values of the map.
+func (m *HashMap) iterator(ctx context.Context) over.KeyIterator {
+	return &hashMapIterator{ctx: ctx, m: m, keys: m.Keys(), i: -1}
+}
+
+type hashMapIterator struct {
+	ctx  context.Context
+	m    *HashMap
+	keys []interface{}
+	i    int
+	err  error
+}
+
+func (it *hashMapIterator) Next() bool {
+	it.i++
+	return it.i < len(it.keys)
+}
+
+func (it *hashMapIterator) Key() interface{} {
+	return it.keys[it.i]
+}
+
+func (it *hashMapIterator) Value() interface{} {
+	value, _, err := it.m.getContext(it.ctx, it.keys[it.i])
+	if err != nil {
+		it.err = err
+	}
+	return value
+}
+
+func (it *hashMapIterator) Err() error {
+	return it.err
+}
+
+// newHashMapFrom returns a HashMap with the keys and values of the Go map m.
+func newHashMapFrom(ctx context.Context, m reflect.Value) (*HashMap, error) {
+	hm := NewHashMap()
+	iter := m.MapRange()
+	for iter.Next() {
+		err := hm.putContext(ctx, iter.Key().Interface(), iter.Value().Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hm, nil
+}
@@ -0,0 +1,252 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+type (
+	// Object is a script value whose methods can be called from Go.
+	// It is passed to the adapters registered with RegisterAdapter.
+	Object struct {
+		ctx context.Context
+		v   reflect.Value
+	}
+
+	// Adapter returns a Go value that implements an interface calling the methods of the Object.
+	// It usually binds the methods of the interface with Object.Bind.
+	Adapter func(o *Object) (interface{}, error)
+)
+
+var (
+	adaptersMutex sync.RWMutex
+	adapters      = make(map[reflect.Type]Adapter)
+)
+
+// Value returns the script value of the Object.
+func (o *Object) Value() interface{} {
+	return o.v.Interface()
+}
+
+// Bind sets the func pointed by fnPtr to call the method called name of the Object.
+// Arguments and results are converted the same way as the package Bind function.
+func (o *Object) Bind(name string, fnPtr interface{}) error {
+	ptr := reflect.ValueOf(fnPtr)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Func {
+		return ErrNotFuncPointer
+	}
+
+	f, recv := o.method(name)
+	if !f.IsValid() {
+		return fmt.Errorf("no method named '%s' for type %v", name, o.v.Type())
+	}
+	ptr.Elem().Set(makeBoundFunc(o.ctx, ptr.Elem().Type(), func(ctx context.Context, in []reflect.Value) (reflect.Value, error) {
+		return callFunc(ctx, f, recv, in)
+	}))
+	return nil
+}
+
+// method returns the method called name and the receiver to call it with.
+// Script methods are the func fields of script structs, other methods have no receiver.
+func (o *Object) method(name string) (reflect.Value, reflect.Value) {
+	v := o.v
+	if v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Struct {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		field := v.FieldByName(name)
+		if field.IsValid() && field.Kind() == reflect.Func && !field.IsNil() {
+			if checkIfRunVMFunction(field.Type()) {
+				return field, v
+			}
+			return field, reflect.Value{}
+		}
+	}
+	if v.CanAddr() {
+		if method := v.Addr().MethodByName(name); method.IsValid() {
+			return method, reflect.Value{}
+		}
+	}
+	return o.v.MethodByName(name), reflect.Value{}
+}
+
+// RegisterAdapter registers the adapter used by AsInterface for the interface iface points to.
+// iface must be a nil pointer to an interface, ex: (*io.Reader)(nil).
+func RegisterAdapter(iface interface{}, adapter Adapter) {
+	t := reflect.TypeOf(iface)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		panic("RegisterAdapter: iface must be a pointer to an interface")
+	}
+	adaptersMutex.Lock()
+	adapters[t.Elem()] = adapter
+	adaptersMutex.Unlock()
+}
+
+// AsInterface returns a Go value implementing the interface iface points to, ex: (*io.Reader)(nil),
+// that calls the methods of the script value.
+// The methods run with ctx, or with the context of the call if the adapted method takes a context.Context first,
+// so cancelling ctx stops them.
+// Script methods can modify self, so script structs must be passed as a pointer (ex: the result of &value).
+// Go cannot create types with methods at runtime, so the interface needs an adapter registered with RegisterAdapter.
+// Adapters are included for error, fmt.Stringer, sort.Interface, http.Handler and the io Reader, Writer and Closer interfaces.
+func AsInterface(ctx context.Context, value interface{}, iface interface{}) (interface{}, error) {
+	t := reflect.TypeOf(iface)
+	if t == nil || t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Interface {
+		return nil, fmt.Errorf("iface must be a pointer to an interface")
+	}
+	t = t.Elem()
+
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return nil, fmt.Errorf("cannot convert nil to %v", t)
+	}
+	if v.Type().Implements(t) {
+		return value, nil
+	}
+	if vs, ok := value.(*vmStruct); ok {
+		v = vs.v
+	}
+
+	adaptersMutex.RLock()
+	adapter, ok := adapters[t]
+	adaptersMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no adapter registered for %v", t)
+	}
+
+	if v.Kind() == reflect.Struct && !v.CanAddr() {
+		// script methods can modify self, a copy would not keep the changes
+		return nil, fmt.Errorf("cannot adapt struct value of type %v, it must be a pointer", v.Type())
+	}
+	adapted, err := adapter(&Object{ctx: ctx, v: v})
+	if err != nil {
+		return nil, err
+	}
+	return adapted, nil
+}
+
+type (
+	errorAdapter struct {
+		error func() string
+	}
+	stringerAdapter struct {
+		string func() string
+	}
+	readerAdapter struct {
+		read func(p []byte) (int, error)
+	}
+	writerAdapter struct {
+		write func(p []byte) (int, error)
+	}
+	closerAdapter struct {
+		close func() error
+	}
+	handlerAdapter struct {
+		serveHTTP func(w http.ResponseWriter, r *http.Request)
+	}
+	sortAdapter struct {
+		len  func() int
+		less func(i, j int) bool
+		swap func(i, j int)
+	}
+)
+
+func (a *errorAdapter) Error() string                { return a.error() }
+func (a *stringerAdapter) String() string            { return a.string() }
+func (a *readerAdapter) Read(p []byte) (int, error)  { return a.read(p) }
+func (a *writerAdapter) Write(p []byte) (int, error) { return a.write(p) }
+func (a *closerAdapter) Close() error                { return a.close() }
+func (a *sortAdapter) Len() int                      { return a.len() }
+func (a *sortAdapter) Less(i, j int) bool            { return a.less(i, j) }
+func (a *sortAdapter) Swap(i, j int)                 { a.swap(i, j) }
+
+func (a *handlerAdapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.serveHTTP(w, r)
+}
+
+func newReaderAdapter(o *Object) (*readerAdapter, error) {
+	a := &readerAdapter{}
+	return a, o.Bind("Read", &a.read)
+}
+
+func newWriterAdapter(o *Object) (*writerAdapter, error) {
+	a := &writerAdapter{}
+	return a, o.Bind("Write", &a.write)
+}
+
+func newCloserAdapter(o *Object) (*closerAdapter, error) {
+	a := &closerAdapter{}
+	return a, o.Bind("Close", &a.close)
+}
+
+func init() {
+	RegisterAdapter((*error)(nil), func(o *Object) (interface{}, error) {
+		a := &errorAdapter{}
+		return a, o.Bind("Error", &a.error)
+	})
+	RegisterAdapter((*fmt.Stringer)(nil), func(o *Object) (interface{}, error) {
+		a := &stringerAdapter{}
+		return a, o.Bind("String", &a.string)
+	})
+	RegisterAdapter((*sort.Interface)(nil), func(o *Object) (interface{}, error) {
+		a := &sortAdapter{}
+		if err := o.Bind("Len", &a.len); err != nil {
+			return nil, err
+		}
+		if err := o.Bind("Less", &a.less); err != nil {
+			return nil, err
+		}
+		return a, o.Bind("Swap", &a.swap)
+	})
+	RegisterAdapter((*http.Handler)(nil), func(o *Object) (interface{}, error) {
+		a := &handlerAdapter{}
+		return a, o.Bind("ServeHTTP", &a.serveHTTP)
+	})
+	RegisterAdapter((*io.Reader)(nil), func(o *Object) (interface{}, error) {
+		return newReaderAdapter(o)
+	})
+	RegisterAdapter((*io.Writer)(nil), func(o *Object) (interface{}, error) {
+		return newWriterAdapter(o)
+	})
+	RegisterAdapter((*io.Closer)(nil), func(o *Object) (interface{}, error) {
+		return newCloserAdapter(o)
+	})
+	RegisterAdapter((*io.ReadWriter)(nil), func(o *Object) (interface{}, error) {
+		r, err := newReaderAdapter(o)
+		if err != nil {
+			return nil, err
+		}
+		w, err := newWriterAdapter(o)
+		return struct {
+			*readerAdapter
+			*writerAdapter
+		}{r, w}, err
+	})
+	RegisterAdapter((*io.ReadCloser)(nil), func(o *Object) (interface{}, error) {
+		r, err := newReaderAdapter(o)
+		if err != nil {
+			return nil, err
+		}
+		c, err := newCloserAdapter(o)
+		return struct {
+			*readerAdapter
+			*closerAdapter
+		}{r, c}, err
+	})
+	RegisterAdapter((*io.WriteCloser)(nil), func(o *Object) (interface{}, error) {
+		w, err := newWriterAdapter(o)
+		if err != nil {
+			return nil, err
+		}
+		c, err := newCloserAdapter(o)
+		return struct {
+			*writerAdapter
+			*closerAdapter
+		}{w, c}, err
+	})
+}
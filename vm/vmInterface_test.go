@@ -0,0 +1,216 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/dgrr/pako/env"
+)
+
+func TestAsInterface(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	err := e.Define("EOF", io.EOF)
+	if err != nil {
+		t.Fatal("Define error:", err)
+	}
+	value, err := Execute(e, nil, `
+struct Buffer {
+	Data []interface,
+	Pos int64
+}
+fn |Buffer| Read(p) {
+	if self.Pos >= len(self.Data) {
+		return 0, EOF
+	}
+	p[0] = self.Data[self.Pos]
+	self.Pos++
+	return 1, nil
+}
+fn |Buffer| Write(p) {
+	for b in p {
+		self.Data += b
+	}
+	return len(p), nil
+}
+fn |Buffer| String() {
+	return "buffer"
+}
+b = make(Buffer)
+b.Data = [97, 98]
+&b
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	reader, err := AsInterface(context.Background(), value, (*io.Reader)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	data, err := ioutil.ReadAll(reader.(io.Reader))
+	if err != nil || string(data) != "ab" {
+		t.Errorf("ReadAll - received: %q, %v - expected: %q, %v", data, err, "ab", nil)
+	}
+
+	readWriter, err := AsInterface(context.Background(), value, (*io.ReadWriter)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	n, err := readWriter.(io.ReadWriter).Write([]byte("c"))
+	if err != nil || n != 1 {
+		t.Errorf("Write - received: %v, %v - expected: %v, %v", n, err, 1, nil)
+	}
+	var buffer bytes.Buffer
+	_, err = io.Copy(&buffer, readWriter.(io.ReadWriter))
+	// the reader shares the position with the first one, that already read "ab"
+	if err != nil || buffer.String() != "c" {
+		t.Errorf("Copy - received: %q, %v - expected: %q, %v", buffer.String(), err, "c", nil)
+	}
+
+	stringer, err := AsInterface(context.Background(), value, (*fmt.Stringer)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	if s := fmt.Sprint(stringer); s != "buffer" {
+		t.Errorf("Sprint - received: %v - expected: %v", s, "buffer")
+	}
+
+	_, err = AsInterface(context.Background(), value, (*io.Closer)(nil))
+	if err == nil || err.Error() != "no method named 'Close' for type "+fmt.Sprintf("%T", value) {
+		t.Errorf("AsInterface error - received: %v - expected: no method named 'Close'", err)
+	}
+	_, err = AsInterface(context.Background(), value, (*io.Seeker)(nil))
+	if err == nil || err.Error() != "no adapter registered for io.Seeker" {
+		t.Errorf("AsInterface error - received: %v - expected: %v", err, "no adapter registered for io.Seeker")
+	}
+	_, err = AsInterface(context.Background(), value, io.EOF)
+	if err == nil || err.Error() != "iface must be a pointer to an interface" {
+		t.Errorf("AsInterface error - received: %v - expected: %v", err, "iface must be a pointer to an interface")
+	}
+
+	r, err := AsInterface(context.Background(), &buffer, (*io.Reader)(nil))
+	if err != nil || r != io.Reader(&buffer) {
+		t.Errorf("AsInterface - received: %v, %v - expected: %v, %v", r, err, &buffer, nil)
+	}
+
+	// the writes reach the script value
+	data2, err := Execute(e, nil, `b.Data`)
+	if err != nil || len(data2.([]interface{})) != 3 {
+		t.Errorf("script value Data - received: %v, %v - expected: 3 values", data2, err)
+	}
+
+	copied, err := Execute(e, nil, `b`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+	_, err = AsInterface(context.Background(), copied, (*io.Reader)(nil))
+	if err == nil || err.Error() != fmt.Sprintf("cannot adapt struct value of type %T, it must be a pointer", copied) {
+		t.Errorf("AsInterface error - received: %v - expected: cannot adapt struct value", err)
+	}
+}
+
+func TestAsInterfaceContext(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	value, err := Execute(e, nil, `
+struct Forever {
+	N int64
+}
+fn |Forever| Read(p) {
+	for { }
+}
+f = make(Forever)
+&f
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	reader, err := AsInterface(ctx, value, (*io.Reader)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	_, err = reader.(io.Reader).Read(make([]byte, 1))
+	if err == nil || err.Error() != ErrInterrupt.Error() {
+		t.Errorf("Read error - received: %v - expected: %v", err, ErrInterrupt)
+	}
+}
+
+func TestAsInterfaceHandler(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	value, err := Execute(e, nil, `
+struct Handler {
+	Count int64
+}
+fn |Handler| ServeHTTP(w, r) {
+	self.Count++
+	w.Header().Set("Path", r.URL.Path)
+	w.WriteHeader(201)
+}
+h = make(Handler)
+&h
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	handler, err := AsInterface(context.Background(), value, (*http.Handler)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	recorder := httptest.NewRecorder()
+	handler.(http.Handler).ServeHTTP(recorder, httptest.NewRequest("GET", "/a", nil))
+	if recorder.Code != 201 || recorder.Header().Get("Path") != "/a" {
+		t.Errorf("ServeHTTP - received: %v, %q - expected: %v, %q", recorder.Code, recorder.Header().Get("Path"), 201, "/a")
+	}
+	count, err := Execute(e, nil, `h.Count`)
+	if err != nil || count != int64(1) {
+		t.Errorf("Count - received: %v, %v - expected: %v", count, err, 1)
+	}
+}
+
+func TestAsInterfaceSort(t *testing.T) {
+	t.Parallel()
+
+	e := env.NewEnv()
+	value, err := Execute(e, nil, `
+struct Items {
+	Values []interface
+}
+fn |Items| Len() { return len(self.Values) }
+fn |Items| Less(i, j) { return self.Values[i] < self.Values[j] }
+fn |Items| Swap(i, j) { self.Values[i], self.Values[j] = self.Values[j], self.Values[i] }
+items = make(Items)
+items.Values = [3, 1, 2]
+&items
+`)
+	if err != nil {
+		t.Fatal("Execute error:", err)
+	}
+
+	items, err := AsInterface(context.Background(), value, (*sort.Interface)(nil))
+	if err != nil {
+		t.Fatal("AsInterface error:", err)
+	}
+	sort.Sort(items.(sort.Interface))
+	for i := 0; i < 2; i++ {
+		if items.(sort.Interface).Less(i+1, i) {
+			t.Errorf("Sort - values not sorted at %v", i)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package vm
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/over"
+)
+
+// scriptIterator is the over.KeyIterator of script structs with __next__ and __value__ methods.
+// The __key__ method is optional.
+type scriptIterator struct {
+	ctx   context.Context
+	v     reflect.Value
+	value reflect.Value
+	key   reflect.Value
+	err   error
+}
+
+// newScriptIterator returns the iterator of v, or nil if v does not have the iterator methods.
+func newScriptIterator(ctx context.Context, v reflect.Value) *scriptIterator {
+	if f, _ := operatorMethod(v, "__next__"); !f.IsValid() {
+		return nil
+	}
+	if f, _ := operatorMethod(v, "__value__"); !f.IsValid() {
+		return nil
+	}
+	return &scriptIterator{ctx: ctx, v: v}
+}
+
+func (it *scriptIterator) call(name string) (reflect.Value, bool) {
+	f, recv := operatorMethod(it.v, name)
+	if !f.IsValid() {
+		return nilValue, false
+	}
+	rv, err := callFunc(it.ctx, f, recv, nil)
+	if err != nil {
+		it.err = err
+		return nilValue, false
+	}
+	return rv, true
+}
+
+func (it *scriptIterator) Next() bool {
+	rv, ok := it.call("__next__")
+	if !ok || !toBool(rv) {
+		return false
+	}
+	if it.value, ok = it.call("__value__"); !ok {
+		return false
+	}
+	if f, _ := operatorMethod(it.v, "__key__"); f.IsValid() {
+		if it.key, ok = it.call("__key__"); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (it *scriptIterator) Value() interface{} {
+	return it.value.Interface()
+}
+
+func (it *scriptIterator) Key() interface{} {
+	if !it.key.IsValid() {
+		return nil
+	}
+	return it.key.Interface()
+}
+
+func (it *scriptIterator) Err() error {
+	return it.err
+}
+
+// iterator returns the over.Iterator of value, calling the __iter__ script method if value has one.
+// It returns nil if value is not iterated lazily.
+func (runInfo *runInfoStruct) iterator(value reflect.Value) (over.Iterator, reflect.Value) {
+	if runInfo.invokeOperatorMethod(value, "__iter__") {
+		if runInfo.err != nil {
+			return nil, nilValue
+		}
+		value = runInfo.rv
+		if value.Kind() == reflect.Interface && !value.IsNil() {
+			value = value.Elem()
+		}
+	}
+	if it := newScriptIterator(runInfo.ctx, value); it != nil {
+		return it, value
+	}
+	if value.IsValid() && value.Type() == hashMapType {
+		return value.Interface().(*HashMap).iterator(runInfo.ctx), value
+	}
+	if value.IsValid() && value.Type().Implements(over.IteratorReflectType) && (value.Kind() != reflect.Ptr || !value.IsNil()) {
+		return value.Interface().(over.Iterator), value
+	}
+	return nil, value
+}
+
+// runForIterator runs the for in loop stmt over it.
+// For two variables the key of an over.KeyIterator or the iteration index is defined in the first one.
+func (runInfo *runInfoStruct) runForIterator(stmt *ast.ForStmt, it over.Iterator) {
+	if gen, ok := it.(*Generator); ok {
+		defer gen.Close()
+	}
+
+	keyIt, hasKey := it.(over.KeyIterator)
+	if si, ok := it.(*scriptIterator); ok {
+		if f, _ := operatorMethod(si.v, "__key__"); !f.IsValid() {
+			hasKey = false
+		}
+	}
+
+	env := runInfo.env
+	for i := int64(0); ; i++ {
+		select {
+		case <-runInfo.ctx.Done():
+			runInfo.err = ErrInterrupt
+			runInfo.rv = nilValue
+			return
+		default:
+		}
+
+		if !it.Next() {
+			if err := it.Err(); err != nil {
+				if _, ok := err.(*Error); !ok && err != ErrInterrupt {
+					err = newError(stmt, err)
+				}
+				runInfo.err = err
+			}
+			break
+		}
+
+		value := reflect.ValueOf(it.Value())
+		if !value.IsValid() {
+			value = nilValue
+		}
+		runInfo.env = env.NewEnv()
+		if len(stmt.Vars) > 1 {
+			key := reflect.ValueOf(i)
+			if hasKey {
+				key = reflect.ValueOf(keyIt.Key())
+				if !key.IsValid() {
+					key = nilValue
+				}
+			}
+			runInfo.env.DefineValue(stmt.Vars[0], key)
+			runInfo.env.DefineValue(stmt.Vars[1], value)
+		} else if mapIt, ok := it.(*hashMapIterator); ok {
+			// like Go maps, HashMaps with one variable loop over the keys
+			key := reflect.ValueOf(mapIt.Key())
+			if !key.IsValid() {
+				key = nilValue
+			}
+			runInfo.env.DefineValue(stmt.Vars[0], key)
+		} else {
+			runInfo.env.DefineValue(stmt.Vars[0], value)
+		}
+
+		runInfo.runLoopBody(stmt.Stmt, stmt.Vars)
+		if runInfo.err != nil {
+			if runInfo.continueLoop(stmt.Label) {
+				continue
+			}
+			if runInfo.err == ErrReturn {
+				return
+			}
+			runInfo.breakLoop(stmt.Label)
+			break
+		}
+	}
+	runInfo.rv = nilValue
+}
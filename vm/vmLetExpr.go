@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -16,7 +17,10 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 		var v reflect.Value
 		runInfo.allocMemUsage(runInfo.rv)
 		v, runInfo.err = runInfo.env.SetValueEvict(expr.Lit, runInfo.rv)
-		if runInfo.err != nil {
+		if errors.Is(runInfo.err, env.ErrConstant) {
+			runInfo.err = newError(expr, runInfo.err)
+			runInfo.rv = nilValue
+		} else if runInfo.err != nil {
 			runInfo.err = runInfo.env.DefineValue(expr.Lit, runInfo.rv)
 		} else {
 			runInfo.deallocMemUsage(v)
@@ -47,6 +51,7 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 		if v, ok := runInfo.rv.Interface().(*vmStruct); ok {
 			runInfo.rv = v.v
 		}
+		recv := runInfo.rv
 
 		if runInfo.rv.Kind() == reflect.Ptr {
 			runInfo.rv = runInfo.rv.Elem()
@@ -56,8 +61,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Struct
 		case reflect.Struct:
-			field, found := runInfo.rv.Type().FieldByName(expr.Name)
+			field, found := fieldByName(runInfo.rv.Type(), expr.Name)
 			if !found {
+				if runInfo.invokeSetter(expr, value, recv) {
+					return
+				}
 				runInfo.err = newStringError(expr, "no member named '"+expr.Name+"' for struct")
 				runInfo.rv = nilValue
 				return
@@ -72,9 +80,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, runInfo.rv.Type())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for struct")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().String()+" for struct"))
+				}
 				runInfo.rv = nilValue
 				return
 			}
@@ -84,9 +94,14 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			value, runInfo.err = convertReflectValueToType(value, runInfo.rv.Type().Elem())
+			if runInfo.invokeSetter(expr, value, recv) {
+				return
+			}
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, runInfo.rv.Type().Elem())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().Elem().String()+" for map")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+runInfo.rv.Type().Elem().String()+" for map"))
+				}
 				runInfo.rv = nilValue
 				return
 			}
@@ -104,6 +119,9 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			runInfo.rv.SetMapIndex(reflect.ValueOf(expr.Name), value)
 
 		default:
+			if runInfo.invokeSetter(expr, value, recv) {
+				return
+			}
 			runInfo.err = newStringError(expr, "type "+runInfo.rv.Kind().String()+" does not support member operation")
 			runInfo.rv = nilValue
 		}
@@ -129,6 +147,17 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 			item = item.Elem()
 		}
 
+		if item.Type() == hashMapType {
+			runInfo.err = item.Interface().(*HashMap).putContext(runInfo.ctx, runInfo.rv.Interface(), value.Interface())
+			if runInfo.err != nil {
+				runInfo.err = newError(expr, runInfo.err)
+				runInfo.rv = nilValue
+				return
+			}
+			runInfo.rv = value
+			return
+		}
+
 		switch item.Kind() {
 
 		// Slice && Array
@@ -143,9 +172,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 			if index == item.Len() {
 				// try to do automatic append
-				value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+				value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type().Elem())
 				if runInfo.err != nil {
-					runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for slice index")
+					if !isCallError(runInfo.err) {
+						runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for slice index"))
+					}
 					runInfo.rv = nilValue
 					return
 				}
@@ -169,9 +200,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()+" for slice index")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()+" for slice index"))
+				}
 				runInfo.rv = nilValue
 				return
 			}
@@ -181,16 +214,42 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 
 		// Map
 		case reflect.Map:
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			if item.Type() == interfaceMapType && isHashKey(runInfo.rv) {
+				// only a nil map can become a HashMap, the other references to a map would not see the new one
+				if !item.IsNil() {
+					runInfo.err = newStringError(expr, "cannot add hashable key of type "+runInfo.typeName(runInfo.rv)+" to "+item.Type().String()+", create the map with a literal that has a hashable key")
+					runInfo.rv = nilValue
+					return
+				}
+				hm := NewHashMap()
+				runInfo.err = hm.putContext(runInfo.ctx, runInfo.rv.Interface(), value.Interface())
+				if runInfo.err != nil {
+					runInfo.err = newError(expr, runInfo.err)
+					runInfo.rv = nilValue
+					return
+				}
+				// assign new map
+				runInfo.rv = reflect.ValueOf(hm)
+				runInfo.expr = expr.Item
+				runInfo.invokeLetExpr()
+				runInfo.rv = value
+				return
+			}
+
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "index type "+runInfo.rv.Type().String()+" cannot be used for map index type "+item.Type().Key().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "index type "+runInfo.rv.Type().String()+" cannot be used for map index type "+item.Type().Key().String()))
+				}
 				runInfo.rv = nilValue
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type().Elem())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type().Elem())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for map")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().Elem().String()+" for map"))
+				}
 				runInfo.rv = nilValue
 				return
 			}
@@ -219,9 +278,11 @@ func (runInfo *runInfoStruct) invokeLetExpr() {
 				return
 			}
 
-			value, runInfo.err = convertReflectValueToType(value, item.Type())
+			value, runInfo.err = convertReflectValueToType(runInfo.ctx, value, item.Type())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(expr, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String())
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(expr, conversionMessage(runInfo.err, "type "+value.Type().String()+" cannot be assigned to type "+item.Type().String()))
+				}
 				runInfo.rv = nilValue
 				return
 			}
@@ -0,0 +1,240 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dgrr/pako/ast"
+)
+
+// invokeMatch evaluates the match expression expr, with the value of the first case matching the value of expr.Expr.
+// The bindings of the patterns are defined in a new env for each case, used by its guard and its expression.
+func (runInfo *runInfoStruct) invokeMatch(expr *ast.MatchExpr) {
+	runInfo.expr = expr.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return
+	}
+	value := runInfo.rv
+
+	env := runInfo.env
+	defer func() {
+		runInfo.env = env
+	}()
+
+	for _, matchCase := range expr.Cases {
+		for _, pattern := range matchCase.Patterns {
+			runInfo.env = env.NewEnv()
+			if !runInfo.matchPattern(pattern, value) {
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
+				continue
+			}
+
+			if matchCase.Guard != nil {
+				runInfo.expr = matchCase.Guard
+				runInfo.invokeExpr()
+				if runInfo.err != nil {
+					return
+				}
+				if !toBool(runInfo.rv) {
+					continue
+				}
+			}
+
+			runInfo.expr = matchCase.Expr
+			runInfo.invokeExpr()
+			return
+		}
+	}
+
+	if expr.Default != nil {
+		runInfo.env = env
+		runInfo.expr = expr.Default
+		runInfo.invokeExpr()
+		return
+	}
+
+	runInfo.err = newStringError(expr, fmt.Sprintf("match is not exhaustive: no case for value %v of type %v", toString(value), runInfo.typeName(value)))
+	runInfo.rv = nilValue
+}
+
+// matchPattern returns true if v matches pattern, defining its bindings in runInfo.env.
+// It sets runInfo.err if the pattern can not be checked.
+func (runInfo *runInfoStruct) matchPattern(pattern ast.Pattern, v reflect.Value) bool {
+	if !v.IsValid() {
+		v = nilValue
+	} else if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch pattern := pattern.(type) {
+	case *ast.BindPattern:
+		runInfo.bindPattern(pattern, pattern.Name, v)
+		return runInfo.err == nil
+
+	case *ast.LiteralPattern:
+		var eq bool
+		eq, runInfo.err = equal(runInfo.ctx, v, pattern.Literal)
+		return eq
+
+	case *ast.RangePattern:
+		return inRange(v, pattern.From, pattern.To)
+
+	case *ast.TypePattern:
+		i, t := runInfo.assertType(pattern, pattern.Type)
+		if runInfo.err != nil || !isType(v, i, t) {
+			return false
+		}
+		runInfo.bindPattern(pattern, pattern.Name, v)
+		return runInfo.err == nil
+
+	case *ast.SlicePattern:
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return false
+		}
+		if v.Len() < len(pattern.Items) || (!pattern.HasRest && v.Len() != len(pattern.Items)) {
+			return false
+		}
+		for i, item := range pattern.Items {
+			if !runInfo.matchPattern(item, v.Index(i)) {
+				return false
+			}
+		}
+		if !pattern.HasRest {
+			return true
+		}
+		var rest reflect.Value
+		if v.Kind() == reflect.Slice {
+			rest = v.Slice(len(pattern.Items), v.Len())
+		} else {
+			rest = reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), v.Len()-len(pattern.Items), v.Len()-len(pattern.Items))
+			for i := 0; i < rest.Len(); i++ {
+				rest.Index(i).Set(v.Index(len(pattern.Items) + i))
+			}
+		}
+		runInfo.bindPattern(pattern, pattern.Rest, rest)
+		return runInfo.err == nil
+
+	case *ast.MapPattern:
+		return runInfo.matchMapPattern(pattern, v)
+
+	case *ast.StructPattern:
+		return runInfo.matchStructPattern(pattern, v)
+	}
+
+	runInfo.err = newStringError(pattern, "unknown pattern")
+	return false
+}
+
+// bindPattern defines name with the value v, unless it is _ or empty.
+func (runInfo *runInfoStruct) bindPattern(pattern ast.Pattern, name string, v reflect.Value) {
+	if name == "" || name == "_" {
+		return
+	}
+	err := runInfo.env.DefineValue(name, v)
+	if err != nil {
+		runInfo.err = newError(pattern, err)
+	}
+}
+
+// matchMapPattern returns true if v is a map with all the keys of pattern, with values matching their patterns.
+func (runInfo *runInfoStruct) matchMapPattern(pattern *ast.MapPattern, v reflect.Value) bool {
+	if !v.CanInterface() {
+		return false
+	}
+	if hm, ok := v.Interface().(*HashMap); ok && hm != nil {
+		for i, key := range pattern.Keys {
+			value, found, err := hm.getContext(runInfo.ctx, key.Interface())
+			if err != nil {
+				runInfo.err = err
+				return false
+			}
+			if !found {
+				return false
+			}
+			if !runInfo.matchPattern(pattern.Values[i], reflect.ValueOf(value)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if v.Kind() != reflect.Map {
+		return false
+	}
+	for i, key := range pattern.Keys {
+		key, err := convertReflectValueToType(runInfo.ctx, key, v.Type().Key())
+		if err != nil {
+			if isCallError(err) {
+				runInfo.err = err
+			}
+			return false
+		}
+		value := v.MapIndex(key)
+		if !value.IsValid() {
+			return false
+		}
+		if !runInfo.matchPattern(pattern.Values[i], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchStructPattern returns true if v is a struct, or a pointer to a struct, of the type of pattern
+// with the fields of pattern matching their patterns.
+func (runInfo *runInfoStruct) matchStructPattern(pattern *ast.StructPattern, v reflect.Value) bool {
+	i, t := runInfo.assertType(pattern, pattern.Type)
+	if runInfo.err != nil {
+		return false
+	}
+	if i != nil || t.Kind() != reflect.Struct {
+		runInfo.err = newStringError(pattern, fmt.Sprintf("%v is not a struct type", typeStructName(pattern.Type, t)))
+		return false
+	}
+
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || v.Type() != t {
+		return false
+	}
+	for j, name := range pattern.Fields {
+		field, found := fieldByName(t, name)
+		if !found {
+			runInfo.err = newStringError(pattern, "no member named '"+name+"' for struct")
+			return false
+		}
+		if !runInfo.matchPattern(pattern.Values[j], v.FieldByIndex(field.Index)) {
+			return false
+		}
+	}
+	return true
+}
+
+// inRange returns true if v is between from and to, both included.
+// Numbers are compared as integers if all of them are integers, otherwise as floats.
+func inRange(v reflect.Value, from reflect.Value, to reflect.Value) bool {
+	switch {
+	case v.Kind() == reflect.String && from.Kind() == reflect.String:
+		return from.String() <= v.String() && v.String() <= to.String()
+	case !isNum(v) || !isNum(from):
+		return false
+	case isInt(v) && isInt(from) && isInt(to):
+		return toInt64(from) <= toInt64(v) && toInt64(v) <= toInt64(to)
+	}
+	return toFloat64(from) <= toFloat64(v) && toFloat64(v) <= toFloat64(to)
+}
+
+// isInt returns true if v is an integer or an unsigned integer.
+func isInt(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
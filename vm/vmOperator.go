@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"reflect"
 	"strings"
 
@@ -8,6 +9,33 @@ import (
 	"github.com/dgrr/pako/over"
 )
 
+var (
+	// arithmeticMethods are the script methods called for the arithmetic operators.
+	arithmeticMethods = map[string]string{
+		"+": "__add__",
+		"-": "__sub__",
+		"*": "__mul__",
+		"/": "__div__",
+		"%": "__mod__",
+	}
+	// unaryMethods are the script methods called for the unary operators.
+	unaryMethods = map[string]string{
+		"-": "__neg__",
+		"!": "__not__",
+		"^": "__bitnot__",
+	}
+	// comparisonMethods are the script methods called for the comparison operators.
+	// != is the negation of __eq__.
+	comparisonMethods = map[string]string{
+		"==": "__eq__",
+		"!=": "__eq__",
+		"<":  "__lt__",
+		"<=": "__le__",
+		">":  "__gt__",
+		">=": "__ge__",
+	}
+)
+
 // invokeOperator evaluates one Operator.
 func (runInfo *runInfoStruct) invokeOperator() {
 	switch operator := runInfo.operator.(type) {
@@ -76,11 +104,24 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if name, ok := comparisonMethods[operator.Operator]; ok {
+			if runInfo.invokeOperatorMethod(lhsV, name, runInfo.rv) {
+				if runInfo.err == nil {
+					runInfo.rv = reflect.ValueOf(toBool(runInfo.rv) != (operator.Operator == "!="))
+				}
+				return
+			}
+		}
+
 		switch operator.Operator {
-		case "==":
-			runInfo.rv = reflect.ValueOf(equal(lhsV, runInfo.rv))
-		case "!=":
-			runInfo.rv = reflect.ValueOf(!equal(lhsV, runInfo.rv))
+		case "==", "!=":
+			var eq bool
+			eq, runInfo.err = equal(runInfo.ctx, lhsV, runInfo.rv)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+			runInfo.rv = reflect.ValueOf(eq != (operator.Operator == "!="))
 		case "<":
 			if lhsV.Type().Implements(over.ComparisonReflectType) {
 				lhv := lhsV.Interface().(over.Comparison)
@@ -155,6 +196,10 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if name, ok := arithmeticMethods[operator.Operator]; ok && runInfo.invokeOperatorMethod(lhsV, name, runInfo.rv) {
+			return
+		}
+
 		switch operator.Operator {
 		case "+":
 			if lhsV.Type().Implements(over.AddReflectType) {
@@ -177,9 +222,11 @@ func (runInfo *runInfoStruct) invokeOperator() {
 					return
 				}
 				// try to append rhs non-slice to lhs slice
-				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, lhsV.Type().Elem())
+				runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, lhsV.Type().Elem())
 				if runInfo.err != nil {
-					runInfo.err = newStringError(operator, "invalid type conversion")
+					if !isCallError(runInfo.err) {
+						runInfo.err = newStringError(operator, conversionMessage(runInfo.err, "invalid type conversion"))
+					}
 					runInfo.rv = nilValue
 					return
 				}
@@ -196,7 +243,16 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			kind := precedenceOfKinds(lhsKind, rhsKind)
 			switch kind {
 			case reflect.String:
-				runInfo.rv = reflect.ValueOf(toString(lhsV) + toString(runInfo.rv))
+				var lhs, rhs string
+				lhs, runInfo.err = toStringContext(runInfo.ctx, lhsV)
+				if runInfo.err == nil {
+					rhs, runInfo.err = toStringContext(runInfo.ctx, runInfo.rv)
+				}
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					return
+				}
+				runInfo.rv = reflect.ValueOf(lhs + rhs)
 			case reflect.Float64, reflect.Float32:
 				runInfo.rv = reflect.ValueOf(toFloat64(lhsV) + toFloat64(runInfo.rv))
 			default:
@@ -263,6 +319,10 @@ func (runInfo *runInfoStruct) invokeOperator() {
 			runInfo.rv = runInfo.rv.Elem()
 		}
 
+		if name, ok := arithmeticMethods[operator.Operator]; ok && runInfo.invokeOperatorMethod(lhsV, name, runInfo.rv) {
+			return
+		}
+
 		switch operator.Operator {
 		case "*":
 			if lhsV.Type().Implements(over.MultiplyReflectType) {
@@ -356,3 +416,125 @@ func (runInfo *runInfoStruct) invokeOperator() {
 
 	}
 }
+
+// operatorMethod returns the script method called name of v and the receiver to call it with.
+// The method is invalid if v is not a script struct or has no such method.
+func operatorMethod(v reflect.Value, name string) (reflect.Value, reflect.Value) {
+	if !v.IsValid() {
+		return reflect.Value{}, reflect.Value{}
+	}
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Ptr && v.Type() == vmStructType && !v.IsNil() {
+		v = v.Interface().(*vmStruct).v
+	}
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}
+	}
+	f := v.FieldByName(methodFieldName(name))
+	if !f.IsValid() || f.Kind() != reflect.Func || f.IsNil() || !checkIfRunVMFunction(f.Type()) {
+		return reflect.Value{}, reflect.Value{}
+	}
+	return f, v
+}
+
+// invokeOperatorMethod calls the script method called name of v with args, setting runInfo.rv and runInfo.err.
+// It returns false if v does not have the method.
+func (runInfo *runInfoStruct) invokeOperatorMethod(v reflect.Value, name string, args ...reflect.Value) bool {
+	f, recv := operatorMethod(v, name)
+	if !f.IsValid() {
+		return false
+	}
+	runInfo.rv, runInfo.err = callFunc(runInfo.ctx, f, recv, args)
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+	}
+	return true
+}
+
+// callOperatorMethod calls the script method called name of v with args outside of invokeExpr.
+// It returns false if v does not have the method, and the error of the call.
+func callOperatorMethod(ctx context.Context, v reflect.Value, name string, args ...reflect.Value) (reflect.Value, bool, error) {
+	f, recv := operatorMethod(v, name)
+	if !f.IsValid() {
+		return nilValue, false, nil
+	}
+	rv, err := callFunc(ctx, f, recv, args)
+	if err != nil {
+		return nilValue, true, err
+	}
+	return rv, true, nil
+}
+
+// invokeGetter calls the over.Getter Get method of v for the member name of expr, setting runInfo.rv and runInfo.err.
+// It returns false if v does not implement over.Getter.
+func (runInfo *runInfoStruct) invokeGetter(expr *ast.MemberExpr, v reflect.Value) bool {
+	if !v.IsValid() || !v.Type().Implements(over.GetterReflectType) {
+		return false
+	}
+	value, err := v.Interface().(over.Getter).Get(expr.Name)
+	if err != nil {
+		runInfo.err = newError(expr, err)
+		runInfo.rv = nilValue
+		return true
+	}
+	runInfo.rv = reflect.ValueOf(value)
+	if !runInfo.rv.IsValid() {
+		runInfo.rv = nilValue
+	}
+	return true
+}
+
+// invokeSetter calls the over.Setter Set method of v for the member name of expr with value, setting runInfo.rv and runInfo.err.
+// It returns false if v does not implement over.Setter.
+func (runInfo *runInfoStruct) invokeSetter(expr *ast.MemberExpr, value reflect.Value, v reflect.Value) bool {
+	if !v.IsValid() || !v.Type().Implements(over.SetterReflectType) {
+		return false
+	}
+	runInfo.err = v.Interface().(over.Setter).Set(expr.Name, value.Interface())
+	if runInfo.err != nil {
+		runInfo.err = newError(expr, runInfo.err)
+		runInfo.rv = nilValue
+		return true
+	}
+	runInfo.rv = value
+	return true
+}
+
+// invokeUnary calls the over.Unary method of v for the operator of expr, setting runInfo.rv and runInfo.err.
+// It returns false if v does not implement over.Unary or the method returns over.ErrMethodNotImplemented.
+func (runInfo *runInfoStruct) invokeUnary(expr *ast.UnaryExpr, v reflect.Value) bool {
+	if !v.IsValid() || !v.Type().Implements(over.UnaryReflectType) {
+		return false
+	}
+	u := v.Interface().(over.Unary)
+	var value interface{}
+	var err error
+	switch expr.Operator {
+	case "-":
+		value, err = u.Neg()
+	case "!":
+		value, err = u.Not()
+	case "^":
+		value, err = u.BitNot()
+	default:
+		return false
+	}
+	if err == over.ErrMethodNotImplemented {
+		return false
+	}
+	if err != nil {
+		runInfo.err = newError(expr, err)
+		runInfo.rv = nilValue
+		return true
+	}
+	runInfo.rv = reflect.ValueOf(value)
+	if !runInfo.rv.IsValid() {
+		runInfo.rv = nilValue
+	}
+	return true
+}
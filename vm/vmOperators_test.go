@@ -1,9 +1,15 @@
 package vm
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/env"
+	"github.com/dgrr/pako/over"
+	"github.com/dgrr/pako/parser"
 )
 
 func TestBasicOperators(t *testing.T) {
@@ -1071,3 +1077,555 @@ func TestTry(t *testing.T) {
 	}
 	runTests(t, tests, nil, &Options{Debug: true})
 }
+
+func TestOperatorMethods(t *testing.T) {
+	t.Parallel()
+
+	structV := `
+struct V {
+	X int64
+}
+fn |V| __add__(o) { r = make(V); r.X = self.X + o.X; return r }
+fn |V| __sub__(o) { r = make(V); r.X = self.X - o; return r }
+fn |V| __mul__(o) { return self.X * o }
+fn |V| __eq__(o) { return self.X == o.X }
+fn |V| __lt__(o) { return self.X < o.X }
+fn |V| __index__(i) { return self.X * i }
+fn |V| __len__() { return self.X }
+fn |V| __str__() { return "V(" + self.X + ")" }
+fn |V| __div__(o) { throw "cannot divide" }
+a = make(V); a.X = 1
+b = make(V); b.X = 2
+`
+	tests := []Test{
+		{Script: structV + `(a + b).X`, RunOutput: int64(3)},
+		{Script: structV + `(b - 1).X`, RunOutput: int64(1)},
+		{Script: structV + `b * 3`, RunOutput: int64(6)},
+		{Script: structV + `a == b`, RunOutput: false},
+		{Script: structV + `a != b`, RunOutput: true},
+		{Script: structV + `c = make(V); c.X = 1; a == c`, RunOutput: true},
+		{Script: structV + `a < b`, RunOutput: true},
+		{Script: structV + `b < a`, RunOutput: false},
+		{Script: structV + `b[5]`, RunOutput: int64(10)},
+		{Script: structV + `len(b)`, RunOutput: int64(2)},
+		{Script: structV + `toString(b)`, Input: map[string]interface{}{"toString": func(s string) string { return s }}, RunOutput: "V(2)"},
+		{Script: structV + `"b is " + b`, RunOutput: "b is V(2)"},
+		{Script: structV + `switch a { case b: return 1; case a: return 2 }`, RunOutput: int64(2)},
+		{Script: structV + `b.__len__()`, RunOutput: int64(2)},
+		{Script: structV + `a / b`, RunError: fmt.Errorf("cannot divide")},
+		{Script: structV + `a > b`, RunOutput: false},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+
+	structE := `
+struct E {
+	X int64
+}
+fn |E| __eq__(o) { throw "cannot compare" }
+fn |E| __str__() { throw "cannot format" }
+a = make(E)
+`
+	tests = []Test{
+		{Script: structE + `"a is " + a`, RunError: fmt.Errorf("cannot format")},
+		{Script: structE + `f"a is ${a}"`, RunError: fmt.Errorf("cannot format")},
+		{Script: structE + `toString(a)`, Input: map[string]interface{}{"toString": func(s string) string { return s }}, RunError: fmt.Errorf("cannot format")},
+		{Script: structE + `a == a`, RunError: fmt.Errorf("cannot compare")},
+		{Script: structE + `a != a`, RunError: fmt.Errorf("cannot compare")},
+		{Script: structE + `a in [a]`, RunError: fmt.Errorf("cannot compare")},
+		{Script: structE + `switch a { case a: return 1 }`, RunError: fmt.Errorf("cannot compare")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+type testRange struct {
+	i, n int64
+	err  error
+}
+
+func (r *testRange) Next() bool {
+	if r.i >= r.n {
+		return false
+	}
+	r.i++
+	return true
+}
+
+func (r *testRange) Value() interface{} {
+	return r.i
+}
+
+func (r *testRange) Err() error {
+	return r.err
+}
+
+type testKeyRange struct {
+	testRange
+}
+
+func (r *testKeyRange) Key() interface{} {
+	return fmt.Sprint("k", r.i)
+}
+
+func TestForIterator(t *testing.T) {
+	t.Parallel()
+
+	structCount := `
+struct Count {
+	N int64
+	Max int64
+}
+fn |Count| __next__() { if self.N == 10 { throw "too many" }; self.N++; return self.N <= self.Max }
+fn |Count| __value__() { return self.N * 10 }
+c = make(Count)
+`
+	structKeys := structCount + `
+fn |Count| __key__() { return "k" + self.N }
+`
+	structIter := `
+struct List {
+	Values []interface
+}
+fn |List| __iter__() { return self.Values }
+l = make(List); l.Values = [1, 2, 3]
+`
+	pullThree := func() interface{} {
+		i := 0
+		return over.Pull(func() (interface{}, bool, error) {
+			i++
+			return i, i <= 3, nil
+		})
+	}
+
+	tests := []Test{
+		{Script: `s = 0; for v in r { s += v }; s`, Input: map[string]interface{}{"r": &testRange{n: 3}}, RunOutput: int64(6)},
+		{Script: `s = []; for k, v in r { s += [[k, v]] }; s`, Input: map[string]interface{}{"r": &testRange{n: 2}}, RunOutput: []interface{}{[]interface{}{int64(0), int64(1)}, []interface{}{int64(1), int64(2)}}},
+		{Script: `s = []; for k, v in r { s += k }; s`, Input: map[string]interface{}{"r": &testKeyRange{testRange{n: 2}}}, RunOutput: []interface{}{"k1", "k2"}},
+		{Script: `s = 0; for v in r { if v == 2 { continue }; if v == 4 { break }; s += v }; s`, Input: map[string]interface{}{"r": &testRange{n: 1 << 62}}, RunOutput: int64(4)},
+		{Script: `fn f() { for v in r { if v == 5 { return v } } }; f()`, Input: map[string]interface{}{"r": &testRange{n: 1 << 62}}, RunOutput: int64(5)},
+		{Script: `for v in r { }`, Input: map[string]interface{}{"r": &testRange{n: 2, err: fmt.Errorf("cursor closed")}}, RunError: fmt.Errorf("cursor closed")},
+		{Script: `s = 0; for v in p { s += v }; s`, Input: map[string]interface{}{"p": pullThree()}, RunOutput: int64(6)},
+
+		{Script: structCount + `c.Max = 3; s = 0; for v in c { s += v }; s`, RunOutput: int64(60)},
+		{Script: structCount + `c.Max = 2; s = []; for k, v in c { s += [[k, v]] }; s`, RunOutput: []interface{}{[]interface{}{int64(0), int64(10)}, []interface{}{int64(1), int64(20)}}},
+		{Script: structKeys + `c.Max = 2; s = []; for k, v in c { s += k }; s`, RunOutput: []interface{}{"k1", "k2"}},
+		{Script: structCount + `c.Max = 20; for v in c { }`, RunError: fmt.Errorf("too many")},
+		{Script: structCount + `c.Max = 20; s = 0; for v in c { if v == 30 { break }; s += v }; s`, RunOutput: int64(30)},
+		{Script: structIter + `s = 0; for v in l { s += v }; s`, RunOutput: int64(6)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+type testDocument struct {
+	Name   string
+	values map[string]interface{}
+}
+
+func (d *testDocument) Get(name string) (interface{}, error) {
+	v, ok := d.values[name]
+	if !ok {
+		return nil, fmt.Errorf("no field %v", name)
+	}
+	return v, nil
+}
+
+func (d *testDocument) Set(name string, v interface{}) error {
+	if name == "readOnly" {
+		return fmt.Errorf("field %v is read only", name)
+	}
+	d.values[name] = v
+	return nil
+}
+
+type testCallable struct {
+	prefix string
+}
+
+func (c testCallable) Call(args ...interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no arguments")
+	}
+	return fmt.Sprintf("%s%v", c.prefix, args), nil
+}
+
+func TestMemberAndCallOverloading(t *testing.T) {
+	t.Parallel()
+
+	newDoc := func() *testDocument {
+		return &testDocument{Name: "doc", values: map[string]interface{}{"a": int64(1), "b": map[string]interface{}{"c": "d"}}}
+	}
+
+	tests := []Test{
+		{Script: `d.a`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: int64(1)},
+		{Script: `d.b.c`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: "d"},
+		{Script: `d.Name`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: "doc"},
+		{Script: `d.x`, Input: map[string]interface{}{"d": newDoc()}, RunError: fmt.Errorf("no field x")},
+		{Script: `d.x = 2; d.x + d.a`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: int64(3)},
+		{Script: `d.Name = "other"; d.Name`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: "other"},
+		{Script: `d.readOnly = 1`, Input: map[string]interface{}{"d": newDoc()}, RunError: fmt.Errorf("field readOnly is read only")},
+		{Script: `d.f = fn(x) { return x * 2 }; d.f(4)`, Input: map[string]interface{}{"d": newDoc()}, RunOutput: int64(8)},
+
+		{Script: `c(1, "a")`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}}, RunOutput: "c:[1 a]"},
+		{Script: `c()`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}}, RunError: fmt.Errorf("no arguments")},
+		{Script: `a = [c]; a[0]("x")`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}}, RunOutput: "c:[x]"},
+		{Script: `d.c = c; d.c("y")`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}, "d": newDoc()}, RunOutput: "c:[y]"},
+		{Script: `c(1)?`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}}, RunOutput: "c:[1]"},
+		{Script: `c([1, 2]...)`, Input: map[string]interface{}{"c": testCallable{prefix: "c:"}}, RunOutput: "c:[1 2]"},
+		{Script: `a = 1; a()`, RunError: fmt.Errorf("cannot call type int64")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+type testDecimal struct {
+	over.UnaryImpl
+	V int64
+}
+
+func (d *testDecimal) Neg() (interface{}, error) {
+	return &testDecimal{V: -d.V}, nil
+}
+
+func (d *testDecimal) BitNot() (interface{}, error) {
+	return nil, fmt.Errorf("decimal does not support ^")
+}
+
+func (d *testDecimal) Binary() bool {
+	return d.V != 0
+}
+
+type testSet map[string]struct{}
+
+func (s testSet) Contains(v interface{}) (bool, error) {
+	key, ok := v.(string)
+	if !ok {
+		return false, fmt.Errorf("set keys are strings")
+	}
+	_, found := s[key]
+	return found, nil
+}
+
+func TestUnaryAndContainsOverloading(t *testing.T) {
+	t.Parallel()
+
+	structV := `
+struct V {
+	X int64
+}
+fn |V| __neg__() { r = make(V); r.X = -self.X; return r }
+fn |V| __not__() { return self.X == 0 }
+fn |V| __bitnot__() { return ^self.X }
+fn |V| __contains__(x) { return x >= 0 && x < self.X }
+a = make(V); a.X = 3
+`
+	tests := []Test{
+		{Script: `(-d).V`, Input: map[string]interface{}{"d": &testDecimal{V: 2}}, RunOutput: int64(-2)},
+		{Script: `b = -d; b.V`, Input: map[string]interface{}{"d": &testDecimal{V: 2}}, RunOutput: int64(-2)},
+		{Script: `!d`, Input: map[string]interface{}{"d": &testDecimal{V: 2}}, RunOutput: false},
+		{Script: `!d`, Input: map[string]interface{}{"d": &testDecimal{}}, RunOutput: true},
+		{Script: `^d`, Input: map[string]interface{}{"d": &testDecimal{V: 2}}, RunError: fmt.Errorf("decimal does not support ^")},
+		{Script: `"a" in s`, Input: map[string]interface{}{"s": testSet{"a": {}}}, RunOutput: true},
+		{Script: `"b" in s`, Input: map[string]interface{}{"s": testSet{"a": {}}}, RunOutput: false},
+		{Script: `1 in s`, Input: map[string]interface{}{"s": testSet{"a": {}}}, RunError: fmt.Errorf("set keys are strings")},
+
+		{Script: structV + `(-a).X`, RunOutput: int64(-3)},
+		{Script: structV + `!a`, RunOutput: false},
+		{Script: structV + `^a`, RunOutput: int64(-4)},
+		{Script: structV + `[2 in a, 3 in a]`, RunOutput: []interface{}{true, false}},
+		{Script: structV + `1 in 2`, RunError: fmt.Errorf("second argument must be slice or array; but have int64")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestInterfacesAndTypeAssertions(t *testing.T) {
+	t.Parallel()
+
+	shapes := `
+interface Shape {
+	Area()
+	Scale(n)
+}
+interface Summer { Sum() }
+struct Square {
+	Side int64
+}
+fn |Square| Area() { return self.Side * self.Side }
+fn |Square| Scale(n) { self.Side *= n }
+struct Label {
+	Text string
+}
+fn |Label| Scale() { }
+s = make(Square)
+s.Side = 3
+l = make(Label)
+`
+	types := map[string]interface{}{
+		"Stringer": reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+		"Point":    testPoint{},
+	}
+	kinds := `
+fn kind(v) {
+	switch v.(type) {
+	case Shape:
+		return "shape"
+	case Summer, Stringer:
+		return "summer"
+	case int64, float64:
+		return "number"
+	case []interface:
+		return "list"
+	default:
+		return "other"
+	}
+}
+`
+	tests := []Test{
+		{Script: `interface Shape {`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `interface Shape { Area() Perimeter() }`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `a = [1]; a.(map)`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: shapes + `s is Shape`, RunOutput: true},
+		{Script: shapes + `l is Shape`, RunOutput: false},
+		{Script: shapes + `nil is Shape`, RunOutput: false},
+		{Script: shapes + `1 is Shape`, RunOutput: false},
+		{Script: shapes + `s is Square`, RunOutput: true},
+		{Script: shapes + `l is Square`, RunOutput: false},
+		{Script: shapes + `!(s is Square)`, RunOutput: false},
+		{Script: shapes + `s is Shape && s.Area() == 9`, RunOutput: true},
+		{Script: shapes + `s.(Shape).Area()`, RunOutput: int64(9)},
+		{Script: shapes + `x = s.(Shape); x.Scale(2); s.Area()`, RunOutput: int64(36)},
+		{Script: shapes + `l.(Shape)`, RunError: fmt.Errorf("value of type Label does not implement Shape (missing method Area)")},
+		{Script: shapes + `struct Bad {
+	X int64
+}
+fn |Bad| Area() { }
+fn |Bad| Scale(a, b) { }
+make(Bad).(Shape)`, RunError: fmt.Errorf("value of type Bad does not implement Shape (missing method Scale)")},
+		{Script: shapes + `l.(Square)`, RunError: fmt.Errorf("value of type Label is not Square")},
+		{Script: shapes + `nil.(Shape)`, RunError: fmt.Errorf("nil is not Shape")},
+		{Script: shapes + `s.(Unknown)`, RunError: fmt.Errorf("undefined type 'Unknown'")},
+
+		{Script: `1 is int64`, RunOutput: true},
+		{Script: `1 is float64`, RunOutput: false},
+		{Script: `"a" is string`, RunOutput: true},
+		{Script: `[1, 2] is []interface`, RunOutput: true},
+		{Script: `1 is interface`, RunOutput: true},
+		{Script: `a = 1; a.(int64) + 1`, RunOutput: int64(2)},
+		{Script: `"a".(int64)`, RunError: fmt.Errorf("value of type string is not int64")},
+
+		{Script: `interface Summer { Sum() }; p is Summer`, Input: map[string]interface{}{"p": &testPoint{X: 1, Y: 2}}, RunOutput: true},
+		{Script: `interface Scaler { Scale(n) }; [p is Scaler, v is Scaler]`, Input: map[string]interface{}{"p": &testPoint{}, "v": testPoint{}}, RunOutput: []interface{}{true, false}},
+		{Script: `interface Scaler { Scale() }; p is Scaler`, Input: map[string]interface{}{"p": &testPoint{}}, RunOutput: false},
+		{Script: `[p is Point, v is Point, p is *Point]`, Input: map[string]interface{}{"p": &testPoint{}, "v": testPoint{}}, Types: types, RunOutput: []interface{}{false, true, true}},
+		{Script: `[b is Stringer, 1 is Stringer]`, Input: map[string]interface{}{"b": &bytes.Buffer{}}, Types: types, RunOutput: []interface{}{true, false}},
+		{Script: `b.(Stringer).String()`, Input: map[string]interface{}{"b": bytes.NewBufferString("a")}, Types: types, RunOutput: "a"},
+
+		{Script: shapes + kinds + `[kind(s), kind(l), kind(1), kind(1.5), kind([1]), kind(nil), kind(p), kind(b)]`, Input: map[string]interface{}{"p": &testPoint{}, "b": &bytes.Buffer{}}, Types: types, RunOutput: []interface{}{"shape", "other", "number", "number", "list", "other", "summer", "summer"}},
+		{Script: `a = 1; switch a.(type) { case string: return 1 }`, RunOutput: nil},
+		{Script: `a = 1; switch a.(type) { case Unknown: return 1 }`, RunError: fmt.Errorf("undefined type 'Unknown'")},
+		{Script: `a = 1; switch a.(type) { case int64: a = 2; b = 3 }; [a, b]`, RunError: fmt.Errorf("undefined symbol 'b'")},
+		{Script: `a = 1; switch a.(type) { default: return 1; default: return 2 }`, ParseError: fmt.Errorf("multiple default statement")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestLabeledBreakAndContinue(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `for { break outer }`, ParseError: fmt.Errorf("break label not defined: outer")},
+		{Script: `for { continue outer }`, ParseError: fmt.Errorf("continue label not defined: outer")},
+		{Script: `outer: for { }; for { break outer }`, ParseError: fmt.Errorf("break label not defined: outer")},
+		{Script: `outer: for { fn() { break outer } }`, ParseError: fmt.Errorf("break label not defined: outer")},
+		{Script: `sw: switch 1 { case 1: for { continue sw } }`, ParseError: fmt.Errorf("invalid continue label sw")},
+		{Script: `outer: a = 1`, ParseError: fmt.Errorf("syntax error")},
+
+		{Script: `s = []; outer: for i = 0; i < 3; i++ { for j = 0; j < 3; j++ { if j == 1 { continue outer }; s += [[i, j]] } }; s`, RunOutput: []interface{}{[]interface{}{int64(0), int64(0)}, []interface{}{int64(1), int64(0)}, []interface{}{int64(2), int64(0)}}},
+		{Script: `s = []; outer: for i = 0; i < 3; i++ { for j = 0; j < 3; j++ { if i == 1 && j == 1 { break outer }; s += [[i, j]] } }; s`, RunOutput: []interface{}{[]interface{}{int64(0), int64(0)}, []interface{}{int64(0), int64(1)}, []interface{}{int64(0), int64(2)}, []interface{}{int64(1), int64(0)}}},
+		{Script: `s = 0; outer: for a in [1, 2, 3] { for b in [10, 20] { if b == 20 { continue outer }; s += a * b } }; s`, RunOutput: int64(60)},
+		{Script: `s = 0; outer:
+for a in [1, 2, 3] {
+	inner: for b in [10, 20] {
+		if a == 2 { break outer }
+		if b == 20 { continue inner }
+		s += a * b
+	}
+}
+s`, RunOutput: int64(10)},
+		{Script: `i = 0; outer: for { for { i++; if i > 2 { break outer }; continue outer } }; i`, RunOutput: int64(3)},
+		{Script: `i = 0; outer: for i < 5 { for { i++; continue outer } }; i`, RunOutput: int64(5)},
+		{Script: `s = 0; outer: for k, v in {"a": 1, "b": 2} { for { s += v; continue outer } }; s`, RunOutput: int64(3)},
+		{Script: `s = 0; outer: for v in r { for { s += v; if v == 2 { break outer }; continue outer } }; s`, Input: map[string]interface{}{"r": &testRange{n: 5}}, RunOutput: int64(3)},
+		{Script: `outer: for { outer: for { break outer }; break }; 1`, RunOutput: int64(1)},
+		{Script: `s = 0; for i = 0; i < 3; i++ { for { s++; break } }; s`, RunOutput: int64(3)},
+
+		{Script: `s = []; for i = 0; i < 3; i++ { sw: switch i { case 1: break sw; s += "never" }; s += i }; s`, RunOutput: []interface{}{int64(0), int64(1), int64(2)}},
+		{Script: `s = []; outer: for i = 0; i < 3; i++ { switch i { case 1: break outer }; s += i }; s`, RunOutput: []interface{}{int64(0)}},
+		{Script: `s = []; outer: for i = 0; i < 3; i++ { switch i { case 1: continue outer }; s += i }; s`, RunOutput: []interface{}{int64(0), int64(2)}},
+		{Script: `s = []; for v in [1, "a"] { ts: switch v.(type) { case string: break ts; s += "never" }; s += v }; s`, RunOutput: []interface{}{int64(1), "a"}},
+		{Script: `a = make(chan int64, 1); a <- 1; s = 0; sel: select { case v = <-a: s = v; break sel; s = 2 }; s`, RunOutput: int64(1)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	structPoint := `
+struct Point {
+	X int64
+	Y int64
+}
+p = make(Point); p.X = 1; p.Y = 2
+`
+	tests := []Test{
+		{Script: `match 1 { case 1: "a" }`, RunOutput: "a"},
+		{Script: `match 2 { case 1: "a"; case 2: "b" }`, RunOutput: "b"},
+		{Script: `match 3 {
+case 1, 2:
+	"a"
+
+case 3, 4: "b"
+}`, RunOutput: "b"},
+		{Script: `a = match "x" { case "x": 1 }; a`, RunOutput: int64(1), Output: map[string]interface{}{"a": int64(1)}},
+		{Script: `match -1 { case -1: true }`, RunOutput: true},
+		{Script: `match nil { case nil: "nil" }`, RunOutput: "nil"},
+		{Script: `match 5 { case 1: "a"; default: "b" }`, RunOutput: "b"},
+		{Script: `match 5 { case x: x + 1 }`, RunOutput: int64(6)},
+		{Script: `x = 1; match 5 { case x: x }; x`, RunOutput: int64(1), Output: map[string]interface{}{"x": int64(1)}},
+		{Script: `match 5 { case _: "any" }`, RunOutput: "any"},
+		{Script: `fn match(a) { return a }; match(2)`, RunOutput: int64(2)},
+
+		// ranges
+		{Script: `fn f(a) { return match a { case 0..9: "digit"; case 10..99: "number"; default: "big" } }; [f(0), f(9), f(10), f(100)]`, RunOutput: []interface{}{"digit", "digit", "number", "big"}},
+		{Script: `match 1.5 { case 1..2: true }`, RunOutput: true},
+		{Script: `match 2 { case 1.5..2.5: true }`, RunOutput: true},
+		{Script: `match -3 { case -5..-1: true }`, RunOutput: true},
+		{Script: `match "b" { case "a".."c": true }`, RunOutput: true},
+		{Script: `match "b" { case 1..2: true; default: false }`, RunOutput: false},
+		{Script: `match 1 { case 1.."a": true }`, ParseError: fmt.Errorf("range pattern bounds must be numbers or strings")},
+		{Script: `match [1] { case [0..2]: match 3 { case 2..4: true } }`, RunOutput: true},
+		{Script: `match {"a": 1} { case {"a": 0..2}: true }`, RunOutput: true},
+		{Script: `match 1 { case 1: 1..2 }`, ParseError: fmt.Errorf("invalid number: 1..2")},
+		{Script: `match 1 { case x if x > 1..2: true }`, ParseError: fmt.Errorf("invalid number: 1..2")},
+		{Script: `a = 1; b = 2; a..b`, ParseError: fmt.Errorf("syntax error on '.' at 1:16"), RunOutput: int64(1)},
+		{Script: `switch 1 { case 1..2: true }`, ParseError: fmt.Errorf("invalid number: 1..2")},
+
+		// types
+		{Script: `match 1 { case is string: "string"; case is int64: "int64" }`, RunOutput: "int64"},
+		{Script: `match "a" { case n is int64: n + 1; case s is string: s + "b" }`, RunOutput: "ab"},
+		{Script: structPoint + `match p { case is Point: true }`, RunOutput: true},
+
+		// guards
+		{Script: `fn sign(a) { return match a { case n if n > 0: 1; case n if n < 0: -1; default: 0 } }; [sign(5), sign(-5), sign(0)]`, RunOutput: []interface{}{int64(1), int64(-1), int64(0)}},
+		{Script: `match 1 { case n is int64 if n > 1: "a"; case n is int64: "b" }`, RunOutput: "b"},
+		{Script: `match 1 { case 1 if a: "a"; default: "b" }`, RunError: fmt.Errorf("undefined symbol 'a'")},
+
+		// slices
+		{Script: `match [1, 2, 3] { case [first, ...rest]: [first, rest] }`, RunOutput: []interface{}{int64(1), []interface{}{int64(2), int64(3)}}},
+		{Script: `match [1] { case [first, ...rest]: len(rest) }`, RunOutput: int64(0)},
+		{Script: `match [] { case [first, ...rest]: "a"; case []: "empty" }`, RunOutput: "empty"},
+		{Script: `match [1, 2] { case [a]: "one"; case [a, b]: a + b }`, RunOutput: int64(3)},
+		{Script: `match [1, 2] { case [...all]: len(all) }`, RunOutput: int64(2)},
+		{Script: `match [1, [2, 3]] { case [1, [x, 3]]: x }`, RunOutput: int64(2)},
+		{Script: `match a { case [x, ...rest]: rest }`, Input: map[string]interface{}{"a": [3]int64{1, 2, 3}}, RunOutput: []int64{2, 3}},
+		{Script: `match "ab" { case [a, b]: true; default: false }`, RunOutput: false},
+
+		// maps
+		{Script: `match {"kind": "circle", "r": 2} { case {"kind": "square", "side": s}: s * s; case {"kind": "circle", "r": r}: r * 3 }`, RunOutput: int64(6)},
+		{Script: `match {"a": 1} { case {"b": b}: "b"; case {}: "map" }`, RunOutput: "map"},
+		{Script: `match {"a": nil} { case {"a": nil}: true }`, RunOutput: true},
+		{Script: `match a { case {"a": x}: x }`, Input: map[string]interface{}{"a": map[string]int64{"a": 1}}, RunOutput: int64(1)},
+		{Script: `match a { case {1: x}: x; default: false }`, Input: map[string]interface{}{"a": map[string]int64{"a": 1}}, RunOutput: false},
+		{Script: `match 1 { case {"a": x}: x; default: false }`, RunOutput: false},
+
+		// structs
+		{Script: structPoint + `match p { case Point{X: 0, Y: y}: "a"; case Point{X: 1, Y: y}: y }`, RunOutput: int64(2)},
+		{Script: structPoint + `match &p { case Point{}: true }`, RunOutput: true},
+		{Script: structPoint + `match 1 { case Point{}: true; default: false }`, RunOutput: false},
+		{Script: structPoint + `match p { case Point{Z: 1}: true }`, RunError: fmt.Errorf("no member named 'Z' for struct")},
+		{Script: `match 1 { case int64{}: true }`, RunError: fmt.Errorf("int64 is not a struct type")},
+
+		// errors
+		{Script: `match 3 { case 1: "a"; case 2: "b" }`, RunError: fmt.Errorf("match is not exhaustive: no case for value 3 of type int64")},
+		{Script: structPoint + `match p { case Point{X: 5}: "a" }`, RunError: fmt.Errorf("match is not exhaustive: no case for value {1 2} of type Point")},
+		{Script: `match 1 { default: 1; default: 2 }`, ParseError: fmt.Errorf("multiple default statement")},
+		{Script: `match 1 { case f"a": 1 }`, ParseError: fmt.Errorf("interpolated strings are not allowed in patterns")},
+		{Script: `match 1 { case a + 1: 1 }`, ParseError: fmt.Errorf("syntax error")},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestMatchErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	stmt, err := parser.ParseSrc("a = 1\nb = match a {\n\tcase 2: 3\n}")
+	if err != nil {
+		t.Fatalf("ParseSrc error - received: %v - expected: %v", err, nil)
+	}
+	_, err = Run(env.NewEnv(), nil, stmt)
+	runErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Run error - received: %#v - expected: *Error", err)
+	}
+	if expected := (ast.Position{Line: 2, Column: 5}); runErr.Pos != expected {
+		t.Errorf("Run error position - received: %v - expected: %v", runErr.Pos, expected)
+	}
+}
+
+func TestConstAndEnum(t *testing.T) {
+	t.Parallel()
+
+	colors := `
+enum Color { Red, Green, Blue }
+`
+	tests := []Test{
+		{Script: `const a`, ParseError: fmt.Errorf("syntax error")},
+		{Script: `enum Color { Red, Red }`, ParseError: fmt.Errorf("duplicate enum member Red")},
+
+		{Script: `const a = 1; a + 1`, RunOutput: int64(2)},
+		{Script: `const a = 1; a = 2`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; a++`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; var a = 2`, RunError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; const a = 2`, RunError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; fn f() { a = 2 }; f()`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; fn f() { var a = 2; return a }; [f(), a]`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `const a = [1]; a[0] = 2; a`, RunOutput: []interface{}{int64(2)}},
+		{Script: `module m { const A = 1 }; m.A = 2`, RunError: fmt.Errorf("cannot assign to constant 'A'")},
+		{Script: `const a = 1; a += 1`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; b, a = 1, 2`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; if true { a = 2 }`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; f = x => a += x`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `fn f() { const a = 1; fn() { a = 2 }() }`, ParseError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `const a = 1; fn f(a) { a = 2; return a }; [f(1), a]`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `const a = 1; fn f() { var a = 0; fn() { a = 2 }(); return a }; [f(), a]`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `const a = 1; fn f() { g = fn() { a = 2 }; var a = 0; g(); return a }; [f(), a]`, RunOutput: []interface{}{int64(2), int64(1)}},
+		{Script: `const a = 1; b = match 2 { case a: a += 1 }; [b, a]`, RunOutput: []interface{}{int64(3), int64(1)}},
+		{Script: `const a = 1; try { throw "x" } catch a { a = 2 }; a`, RunOutput: int64(1)},
+		{Script: `fn f() { a = 2 }; const a = 1; f()`, RunError: fmt.Errorf("cannot assign to constant 'a'")},
+		{Script: `a = []; for i = 0; i < 3; i++ { const X = i; a += X }; a`, RunOutput: []interface{}{int64(0), int64(1), int64(2)}},
+		{Script: `a = []; i = 0; for i < 3 { const Y = i; a += Y; i++ }; a`, RunOutput: []interface{}{int64(0), int64(1), int64(2)}},
+		{Script: `a = []; i = 0; for { const Z = i; i++; if i > 2 { break }; a += Z }; a`, RunOutput: []interface{}{int64(0), int64(1)}},
+		{Script: `a = []; for v in [1, 2] { const W = v; a += W }; a`, RunOutput: []interface{}{int64(1), int64(2)}},
+		{Script: `for i = 0; i < 3; i++ { const X = i; X = 1 }`, ParseError: fmt.Errorf("cannot assign to constant 'X'")},
+
+		{Script: colors + `Color.Green.String()`, RunOutput: "Green"},
+		{Script: colors + `Color.Blue.Ordinal()`, RunOutput: int64(2)},
+		{Script: colors + `f"${Color.Red}"`, RunOutput: "Red"},
+		{Script: colors + `Color.Red == Color.Red`, RunOutput: true},
+		{Script: colors + `Color.Red == Color.Green`, RunOutput: false},
+		{Script: colors + `Color[1].String()`, RunOutput: "Green"},
+		{Script: colors + `len(Color)`, RunOutput: int64(3)},
+		{Script: colors + `a = []; for c in Color { a += c.String() }; a`, RunOutput: []interface{}{"Red", "Green", "Blue"}},
+		{Script: colors + `Color.Purple`, RunError: fmt.Errorf("no member named 'Purple' for enum Color")},
+		{Script: colors + `Color = 5`, ParseError: fmt.Errorf("cannot assign to constant 'Color'")},
+		{Script: `interface Shape { Area() }; Shape = 5`, ParseError: fmt.Errorf("cannot assign to constant 'Shape'")},
+		{Script: colors + `[Color.Red is Color, 1 is Color]`, RunOutput: []interface{}{true, false}},
+		{Script: colors + `enum Size { Small }; Size.Small is Color`, RunOutput: false},
+		{Script: colors + `Color.Red.(Color).String()`, RunOutput: "Red"},
+		{Script: colors + `a = 1; a.(Color)`, RunError: fmt.Errorf("value of type int64 is not Color")},
+		{Script: colors + `enum Size { Small }; Size.Small.(Color)`, RunError: fmt.Errorf("value of type Size is not Color")},
+		{Script: colors + `c = Color.Blue; switch c.(type) { case Color: return c.Ordinal() }`, RunOutput: int64(2)},
+		{Script: colors + `match Color.Green { case c is Color if c == Color.Red: "red"; default: "other" }`, RunOutput: "other"},
+		{Script: `fn f() { return Color.Red.String() }; enum Color { Red }; f()`, RunOutput: "Red"},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
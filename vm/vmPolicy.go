@@ -0,0 +1,237 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// Policy restricts the capabilities of the scripts run with it.
+//
+// Entries in Allow and Deny can be a package name (ex: "os") or
+// a package member (ex: "os.Getenv").
+type Policy struct {
+	// Allow lists the packages and members that can be imported.
+	// If empty, everything that is not denied can be imported.
+	Allow []string
+	// Deny lists the packages and members that cannot be imported.
+	// Deny takes precedence over Allow.
+	Deny []string
+	// FSRoots restricts the paths passed to the functions listed in FilePathArgs.
+	// If empty, paths are not restricted.
+	//
+	// FSRoots only covers the functions listed in FilePathArgs. Other members that reach the filesystem,
+	// like the ones of os/exec, os.NewFile, or the methods of values returned by the listed functions,
+	// are not restricted by it, so they must be denied too to keep scripts inside of the roots.
+	FSRoots []string
+}
+
+var (
+	// FilePathArgs maps the package members that touch the filesystem
+	// to the index of their path arguments.
+	// It is used to apply Policy.FSRoots.
+	FilePathArgs = map[string][]int{
+		"os.Chdir":                   {0},
+		"os.Chmod":                   {0},
+		"os.Chown":                   {0},
+		"os.Chtimes":                 {0},
+		"os.Create":                  {0},
+		"os.CreateTemp":              {0},
+		"os.DirFS":                   {0},
+		"os.Lchown":                  {0},
+		"os.Link":                    {0, 1},
+		"os.Lstat":                   {0},
+		"os.Mkdir":                   {0},
+		"os.MkdirAll":                {0},
+		"os.MkdirTemp":               {0},
+		"os.Open":                    {0},
+		"os.OpenFile":                {0},
+		"os.ReadDir":                 {0},
+		"os.ReadFile":                {0},
+		"os.Readlink":                {0},
+		"os.Remove":                  {0},
+		"os.RemoveAll":               {0},
+		"os.Rename":                  {0, 1},
+		"os.StartProcess":            {0},
+		"os.Stat":                    {0},
+		"os.Symlink":                 {0, 1},
+		"os.Truncate":                {0},
+		"os.WriteFile":               {0},
+		"io/ioutil.ReadDir":          {0},
+		"io/ioutil.ReadFile":         {0},
+		"io/ioutil.TempDir":          {0},
+		"io/ioutil.TempFile":         {0},
+		"io/ioutil.WriteFile":        {0},
+		"path/filepath.EvalSymlinks": {0},
+		"path/filepath.Glob":         {0},
+		"path/filepath.Walk":         {0},
+		"path/filepath.WalkDir":      {0},
+	}
+
+	// ErrPathNotAllowed is returned when a path is outside of Policy.FSRoots.
+	ErrPathNotAllowed = errors.New("path not allowed")
+)
+
+// maxLinks is the number of symbolic links resolvePath follows before failing.
+const maxLinks = 255
+
+// listed returns true if the package or the member is in list.
+// Listing a package allows all of its members.
+func listed(list []string, pkg, member string) bool {
+	for _, s := range list {
+		if s == pkg || (member != "" && s == pkg+"."+member) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowPackage returns true if the package pkg can be imported.
+func (p *Policy) AllowPackage(pkg string) bool {
+	if p == nil {
+		return true
+	}
+	if listed(p.Deny, pkg, "") {
+		return false
+	}
+	if len(p.Allow) == 0 {
+		return true
+	}
+	for _, s := range p.Allow {
+		if s == pkg || strings.HasPrefix(s, pkg+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowMember returns true if member of the package pkg can be imported.
+func (p *Policy) AllowMember(pkg, member string) bool {
+	if p == nil {
+		return true
+	}
+	if listed(p.Deny, pkg, member) {
+		return false
+	}
+	return len(p.Allow) == 0 || listed(p.Allow, pkg, member)
+}
+
+// CheckPath returns ErrPathNotAllowed if path is outside of FSRoots.
+// Symbolic links are resolved before checking, so links cannot point outside of the roots.
+func (p *Policy) CheckPath(path string) error {
+	if p == nil || len(p.FSRoots) == 0 {
+		return nil
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	resolved, err := resolvePath(path, 0)
+	if err != nil {
+		return err
+	}
+	for _, root := range p.FSRoots {
+		root, err = filepath.Abs(root)
+		if err != nil {
+			return err
+		}
+		root, err = resolvePath(root, 0)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, resolved)
+		if err != nil {
+			continue
+		}
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v: %s", ErrPathNotAllowed, path)
+}
+
+// resolvePath returns the absolute path with its symbolic links resolved.
+// Unlike filepath.EvalSymlinks, the path does not have to exist,
+// so the paths of files that are going to be created are resolved through their existing parents.
+// Links is the number of links followed so far.
+func resolvePath(path string, links int) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return resolved, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+	dir := filepath.Dir(path)
+	if dir == path {
+		return path, nil
+	}
+	dir, err = resolvePath(dir, links)
+	if err != nil {
+		return "", err
+	}
+	path = filepath.Join(dir, filepath.Base(path))
+
+	// a link to a path that does not exist
+	target, err := os.Readlink(path)
+	if err != nil {
+		return path, nil
+	}
+	if links++; links > maxLinks {
+		return "", fmt.Errorf("too many links: %s", path)
+	}
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(dir, target)
+	}
+	return resolvePath(target, links)
+}
+
+// restrictValue returns the value of the package member applying the policy restrictions,
+// and false if the value is not restricted.
+func (p *Policy) restrictValue(pkg, member string, value reflect.Value) (reflect.Value, bool) {
+	if p == nil || len(p.FSRoots) == 0 || value.Kind() != reflect.Func {
+		return value, false
+	}
+	args, ok := FilePathArgs[pkg+"."+member]
+	if !ok {
+		return value, false
+	}
+
+	t := value.Type()
+	return reflect.MakeFunc(t, func(in []reflect.Value) []reflect.Value {
+		for _, i := range args {
+			if i >= len(in) || in[i].Kind() != reflect.String {
+				continue
+			}
+			path := in[i].String()
+			if pkg == "os" && member == "Symlink" && i == 0 && !filepath.IsAbs(path) && len(in) > 1 {
+				// relative link targets are relative to the directory of the link, not to the working directory
+				path = filepath.Join(filepath.Dir(in[1].String()), path)
+			}
+			if err := p.CheckPath(path); err != nil {
+				return errorReturnValues(t, err)
+			}
+		}
+		if t.IsVariadic() {
+			return value.CallSlice(in)
+		}
+		return value.Call(in)
+	}), true
+}
+
+// errorReturnValues returns the zero values of the outputs of t with err as the last value.
+// If the last output of t is not an error, it panics with err.
+func errorReturnValues(t reflect.Type, err error) []reflect.Value {
+	if t.NumOut() == 0 || t.Out(t.NumOut()-1) != errorType {
+		panic(err)
+	}
+	rvs := make([]reflect.Value, t.NumOut())
+	for i := 0; i < len(rvs)-1; i++ {
+		rvs[i] = reflect.Zero(t.Out(i))
+	}
+	rvs[len(rvs)-1] = reflect.ValueOf(&err).Elem()
+	return rvs
+}
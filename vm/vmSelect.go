@@ -0,0 +1,110 @@
+package vm
+
+import (
+	"reflect"
+
+	"github.com/dgrr/pako/ast"
+)
+
+// runSelect runs the select statement stmt with reflect.Select.
+// The channel and send expressions are evaluated once, in source order, before selecting.
+// The Done channel of the run context is always selected, so cancellation interrupts a blocked select.
+// Like in switch statements, break and continue without label apply to the enclosing loop.
+func (runInfo *runInfoStruct) runSelect(stmt *ast.SelectStmt) {
+	env := runInfo.env
+	runInfo.env = env.NewEnv()
+	defer func() {
+		runInfo.env = env
+	}()
+
+	cases := make([]reflect.SelectCase, 1, len(stmt.Cases)+2)
+	cases[0] = reflect.SelectCase{
+		Dir:  reflect.SelectRecv,
+		Chan: reflect.ValueOf(runInfo.ctx.Done()),
+	}
+	for _, selectCaseStmt := range stmt.Cases {
+		caseStmt := selectCaseStmt.(*ast.SelectCaseStmt)
+		runInfo.expr = caseStmt.Chan
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		if runInfo.rv.Kind() == reflect.Interface && !runInfo.rv.IsNil() {
+			runInfo.rv = runInfo.rv.Elem()
+		}
+		ch := runInfo.rv
+		if ch.Kind() != reflect.Chan {
+			if caseStmt.Send == nil {
+				runInfo.err = newStringError(caseStmt, "receive from non-chan type "+ch.Kind().String())
+			} else {
+				runInfo.err = newStringError(caseStmt, "send to non-chan type "+ch.Kind().String())
+			}
+			runInfo.rv = nilValue
+			return
+		}
+
+		if caseStmt.Send == nil {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: ch})
+			continue
+		}
+
+		runInfo.expr = caseStmt.Send
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		value, err := convertReflectValueToType(runInfo.ctx, runInfo.rv, ch.Type().Elem())
+		if isCallError(err) {
+			runInfo.err = err
+			runInfo.rv = nilValue
+			return
+		}
+		if err != nil {
+			runInfo.err = newStringError(caseStmt, "cannot use type "+runInfo.rv.Type().String()+" as type "+ch.Type().Elem().String()+" to send to chan")
+			runInfo.rv = nilValue
+			return
+		}
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectSend, Chan: ch, Send: value})
+	}
+	if stmt.Default != nil {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+
+	if !runInfo.options.Debug {
+		// captures panic
+		defer recoverFunc(runInfo)
+	}
+	chosen, recv, ok := reflect.Select(cases)
+	if chosen == 0 {
+		runInfo.err = ErrInterrupt
+		runInfo.rv = nilValue
+		return
+	}
+
+	if chosen > len(stmt.Cases) {
+		runInfo.stmt = stmt.Default
+	} else {
+		caseStmt := stmt.Cases[chosen-1].(*ast.SelectCaseStmt)
+		if caseStmt.OkExpr != nil {
+			runInfo.rv = reflect.ValueOf(ok)
+			runInfo.expr = caseStmt.OkExpr
+			runInfo.invokeLetExpr()
+			if runInfo.err != nil {
+				return
+			}
+		}
+		if caseStmt.LHS != nil {
+			// closed channels set the zero value, like in Go
+			runInfo.rv = recv
+			runInfo.expr = caseStmt.LHS
+			runInfo.invokeLetExpr()
+			if runInfo.err != nil {
+				return
+			}
+		}
+		runInfo.stmt = caseStmt.Stmt
+	}
+
+	runInfo.runSingleStmt()
+	runInfo.breakLabel(stmt.Label)
+}
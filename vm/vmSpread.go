@@ -0,0 +1,124 @@
+package vm
+
+import (
+	"reflect"
+
+	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/over"
+)
+
+// spreadSlice returns the values of a spread expression in a slice literal.
+// Slices and arrays are spread by index, iterators as generators until they end.
+func (runInfo *runInfoStruct) spreadSlice(expr *ast.SpreadExpr) []reflect.Value {
+	runInfo.expr = expr.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return nil
+	}
+	value := runInfo.rv
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		values := make([]reflect.Value, value.Len())
+		for i := range values {
+			values[i] = value.Index(i)
+		}
+		return values
+	}
+
+	var it over.Iterator
+	if value.IsValid() && value.Kind() != reflect.Map && value.Type() != hashMapType {
+		it, value = runInfo.iterator(value)
+		if runInfo.err != nil {
+			return nil
+		}
+	}
+	if it == nil {
+		runInfo.err = newStringError(expr, "cannot spread type "+runInfo.typeName(value)+" in slice literal")
+		runInfo.rv = nilValue
+		return nil
+	}
+	if gen, ok := it.(*Generator); ok {
+		defer gen.Close()
+	}
+
+	var values []reflect.Value
+	for {
+		select {
+		case <-runInfo.ctx.Done():
+			runInfo.err = ErrInterrupt
+			runInfo.rv = nilValue
+			return nil
+		default:
+		}
+
+		if !it.Next() {
+			if err := it.Err(); err != nil {
+				if _, ok := err.(*Error); !ok && err != ErrInterrupt {
+					err = newError(expr, err)
+				}
+				runInfo.err = err
+				runInfo.rv = nilValue
+				return nil
+			}
+			return values
+		}
+
+		value := reflect.ValueOf(it.Value())
+		if !value.IsValid() {
+			value = nilValue
+		}
+		values = append(values, value)
+	}
+}
+
+// spreadMap returns the keys and the values of a spread expression in a map literal.
+func (runInfo *runInfoStruct) spreadMap(expr *ast.SpreadExpr) ([]reflect.Value, []reflect.Value) {
+	runInfo.expr = expr.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return nil, nil
+	}
+	value := runInfo.rv
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if value.IsValid() && value.Type() == hashMapType {
+		hm := value.Interface().(*HashMap)
+		keys := hm.Keys()
+		keyValues := make([]reflect.Value, len(keys))
+		values := make([]reflect.Value, len(keys))
+		for i, key := range keys {
+			v, _, err := hm.getContext(runInfo.ctx, key)
+			if err != nil {
+				runInfo.err = newError(expr, err)
+				runInfo.rv = nilValue
+				return nil, nil
+			}
+			keyValues[i] = reflect.ValueOf(key)
+			values[i] = reflect.ValueOf(v)
+			if !values[i].IsValid() {
+				values[i] = nilValue
+			}
+		}
+		return keyValues, values
+	}
+
+	if value.Kind() != reflect.Map {
+		runInfo.err = newStringError(expr, "cannot spread type "+runInfo.typeName(value)+" in map literal")
+		runInfo.rv = nilValue
+		return nil, nil
+	}
+	keys := value.MapKeys()
+	values := make([]reflect.Value, len(keys))
+	for i, key := range keys {
+		values[i] = value.MapIndex(key)
+		if keys[i].Kind() == reflect.Interface && !keys[i].IsNil() {
+			keys[i] = keys[i].Elem()
+		}
+	}
+	return keys, values
+}
@@ -38,7 +38,12 @@ func Run(env *env.Env, options *Options, stmt ast.Stmt) (interface{}, error) {
 }
 
 // RunContext executes statement in the specified environment with context.
+// The generators started by the statement that did not return are stopped when it returns.
+// When ctx is done, the deferred calls of the functions being run can still run for options.DeferGracePeriod.
 func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.Stmt) (interface{}, error) {
+	ctx, running := withRunningGenerators(ctx)
+	defer running.stop()
+
 	runInfo := runInfoStruct{ctx: ctx, env: env, options: options, stmt: stmt, rv: nilValue}
 	if runInfo.options == nil {
 		runInfo.options = &Options{}
@@ -47,6 +52,7 @@ func RunContext(ctx context.Context, env *env.Env, options *Options, stmt ast.St
 	runInfo.runDecls(ctx)
 	if runInfo.err == nil {
 		runInfo.runSingleStmt()
+		runInfo.runDefers()
 		if runInfo.err == ErrReturn {
 			runInfo.err = nil
 		}
@@ -73,12 +79,14 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	// StmtsStmt
 	case *ast.StmtsStmt:
 		for _, stmt := range stmt.Stmts {
-			switch stmt.(type) {
+			switch stmt := stmt.(type) {
 			case *ast.BreakStmt:
 				runInfo.err = ErrBreak
+				runInfo.label = stmt.Label
 				return
 			case *ast.ContinueStmt:
 				runInfo.err = ErrContinue
+				runInfo.label = stmt.Label
 				return
 			case *ast.ReturnStmt:
 				runInfo.stmt = stmt
@@ -129,7 +137,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.rv = nilValue
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, stringType)
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, stringType)
 			if runInfo.err != nil {
 				runInfo.rv = nilValue
 				return
@@ -175,25 +183,50 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				return
 			}
 		} else {
-			methods, ok := env.Packages[name]
+			policy := runInfo.options.Policy
+			if !policy.AllowPackage(name) {
+				runInfo.err = newStringError(stmt, "package not allowed: "+name)
+				return
+			}
+
+			pkg, ok := env.GetPackage(name)
 			if !ok {
 				runInfo.err = newStringError(stmt, "package not found: "+name)
+				return
 			}
 
 			pack = runInfo.env.NewEnv()
-			for methodName, methodValue := range methods {
+			for methodName, methodValue := range pkg.Values {
 				err = pack.DefineValue(methodName, methodValue)
 				if err != nil {
 					runInfo.err = newStringError(stmt, "import DefineValue error: "+err.Error())
 					return
 				}
 			}
-			types, ok := env.PackageTypes[name]
-			if ok {
-				for typeName, typeValue := range types {
-					err = pack.DefineReflectType(typeName, typeValue)
+			for typeName, typeValue := range pkg.Types {
+				err = pack.DefineReflectType(typeName, typeValue)
+				if err != nil {
+					runInfo.err = newStringError(stmt, "import DefineReflectType error: "+err.Error())
+					return
+				}
+			}
+			if pkg.Init != nil {
+				err = pkg.Init(pack)
+				if err != nil {
+					runInfo.err = newStringError(stmt, "import Init error: "+err.Error())
+					return
+				}
+			}
+
+			// the policy is applied after Init, so the members defined by Init are restricted too
+			pack.Filter(func(member string) bool {
+				return policy.AllowMember(name, member)
+			})
+			for methodName, methodValue := range pack.Values() {
+				if methodValue, ok := policy.restrictValue(name, methodName, methodValue); ok {
+					err = pack.DefineValue(methodName, methodValue)
 					if err != nil {
-						runInfo.err = newStringError(stmt, "import DefineReflectType error: "+err.Error())
+						runInfo.err = newStringError(stmt, "import DefineValue error: "+err.Error())
 						return
 					}
 				}
@@ -216,8 +249,39 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		runInfo.vmTypes = append(runInfo.vmTypes, stmt.Name)
 		runInfo.env.DefineReflectType(stmt.Name, t)
+		runInfo.env.DefineDefaults(stmt.Name, reflect.ValueOf(&structDefaults{env: runInfo.env, fields: runInfo.fieldDefaults(stmt.Body, nil, nil)}))
+		runInfo.promoteMethods(stmt.Name, t)
+		runInfo.rv = nilValue
+
+	// InterfaceStmt
+	case *ast.InterfaceStmt:
+		if err := runInfo.env.DefineConstValue(stmt.Name, reflect.ValueOf(&vmInterface{name: stmt.Name, methods: stmt.Methods})); err != nil {
+			runInfo.err = newError(stmt, err)
+		}
 		runInfo.rv = nilValue
 
+	// EnumStmt
+	case *ast.EnumStmt:
+		if err := runInfo.env.DefineConstValue(stmt.Name, reflect.ValueOf(newEnum(stmt.Name, stmt.Members))); err != nil {
+			runInfo.err = newError(stmt, err)
+		}
+		runInfo.rv = nilValue
+
+	// ConstStmt
+	case *ast.ConstStmt:
+		runInfo.expr = stmt.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		if env, ok := runInfo.rv.Interface().(*env.Env); ok {
+			runInfo.rv = reflect.ValueOf(env.DeepCopy())
+		}
+		if err := runInfo.env.DefineConstValue(stmt.Name, runInfo.rv); err != nil {
+			runInfo.err = newError(stmt, err)
+			runInfo.rv = nilValue
+		}
+
 	// VarStmt
 	case *ast.VarStmt:
 		// get right side expression values
@@ -249,7 +313,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Len() > 0 {
 				// value is slice/array, add each value to left side names
 				for i := 0; i < value.Len() && i < len(stmt.Names); i++ {
-					runInfo.env.DefineValue(stmt.Names[i], value.Index(i))
+					if err := runInfo.env.DefineValue(stmt.Names[i], value.Index(i)); err != nil {
+						runInfo.err = newError(stmt, err)
+						runInfo.rv = nilValue
+						return
+					}
 				}
 				// return last value of slice/array
 				runInfo.rv = value.Index(value.Len() - 1)
@@ -259,7 +327,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 
 		// define all names with right side values
 		for i = 0; i < len(rvs) && i < len(stmt.Names); i++ {
-			runInfo.env.DefineValue(stmt.Names[i], rvs[i])
+			if err := runInfo.env.DefineValue(stmt.Names[i], rvs[i]); err != nil {
+				runInfo.err = newError(stmt, err)
+				runInfo.rv = nilValue
+				return
+			}
 		}
 
 		// return last right side value
@@ -457,7 +529,8 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	// LoopStmt
 	case *ast.LoopStmt:
 		env := runInfo.env
-		runInfo.env = env.NewEnv()
+		loopEnv := env.NewEnv()
+		runInfo.env = loopEnv
 
 		for {
 			select {
@@ -480,20 +553,18 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				}
 			}
 
-			runInfo.stmt = stmt.Stmt
-			runInfo.runSingleStmt()
+			runInfo.env = loopEnv.NewEnv()
+			runInfo.runLoopBody(stmt.Stmt, nil)
+			runInfo.env = loopEnv
 			if runInfo.err != nil {
-				if runInfo.err == ErrContinue {
-					runInfo.err = nil
+				if runInfo.continueLoop(stmt.Label) {
 					continue
 				}
 				if runInfo.err == ErrReturn {
 					runInfo.env = env
 					return
 				}
-				if runInfo.err == ErrBreak {
-					runInfo.err = nil
-				}
+				runInfo.breakLoop(stmt.Label)
 				break
 			}
 		}
@@ -514,7 +585,19 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 
 		env := runInfo.env
-		runInfo.env = env.NewEnv()
+		loopEnv := env.NewEnv()
+
+		it, value := runInfo.iterator(value)
+		if runInfo.err != nil {
+			runInfo.env = env
+			return
+		}
+		if it != nil {
+			runInfo.env = loopEnv
+			runInfo.runForIterator(stmt, it)
+			runInfo.env = env
+			return
+		}
 
 		if value.Type().Implements(over.IndexReflectType) {
 			v := value.Interface().(over.Index)
@@ -531,6 +614,7 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				vi, err := v.Index(i)
 				if err != nil {
 					runInfo.err = newError(runInfo.stmt, err)
+					runInfo.env = env
 					return
 				}
 
@@ -539,22 +623,19 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				if iv.Kind() == reflect.Interface && !iv.IsNil() {
 					iv = iv.Elem()
 				}
+				runInfo.env = loopEnv.NewEnv()
 				runInfo.env.DefineValue(stmt.Vars[0], iv)
 
-				runInfo.stmt = stmt.Stmt
-				runInfo.runSingleStmt()
+				runInfo.runLoopBody(stmt.Stmt, stmt.Vars)
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
-						runInfo.err = nil
+					if runInfo.continueLoop(stmt.Label) {
 						continue
 					}
 					if runInfo.err == ErrReturn {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
-						runInfo.err = nil
-					}
+					runInfo.breakLoop(stmt.Label)
 					break
 				}
 			}
@@ -582,22 +663,19 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				if iv.Kind() == reflect.Ptr {
 					iv = iv.Elem()
 				}
+				runInfo.env = loopEnv.NewEnv()
 				runInfo.env.DefineValue(stmt.Vars[0], iv)
 
-				runInfo.stmt = stmt.Stmt
-				runInfo.runSingleStmt()
+				runInfo.runLoopBody(stmt.Stmt, stmt.Vars)
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
-						runInfo.err = nil
+					if runInfo.continueLoop(stmt.Label) {
 						continue
 					}
 					if runInfo.err == ErrReturn {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
-						runInfo.err = nil
-					}
+					runInfo.breakLoop(stmt.Label)
 					break
 				}
 			}
@@ -616,26 +694,23 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				default:
 				}
 
+				runInfo.env = loopEnv.NewEnv()
 				runInfo.env.DefineValue(stmt.Vars[0], keys[i])
 
 				if len(stmt.Vars) > 1 {
 					runInfo.env.DefineValue(stmt.Vars[1], value.MapIndex(keys[i]))
 				}
 
-				runInfo.stmt = stmt.Stmt
-				runInfo.runSingleStmt()
+				runInfo.runLoopBody(stmt.Stmt, stmt.Vars)
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
-						runInfo.err = nil
+					if runInfo.continueLoop(stmt.Label) {
 						continue
 					}
 					if runInfo.err == ErrReturn {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
-						runInfo.err = nil
-					}
+					runInfo.breakLoop(stmt.Label)
 					break
 				}
 			}
@@ -670,22 +745,19 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					runInfo.rv = runInfo.rv.Elem()
 				}
 
+				runInfo.env = loopEnv.NewEnv()
 				runInfo.env.DefineValue(stmt.Vars[0], runInfo.rv)
 
-				runInfo.stmt = stmt.Stmt
-				runInfo.runSingleStmt()
+				runInfo.runLoopBody(stmt.Stmt, stmt.Vars)
 				if runInfo.err != nil {
-					if runInfo.err == ErrContinue {
-						runInfo.err = nil
+					if runInfo.continueLoop(stmt.Label) {
 						continue
 					}
 					if runInfo.err == ErrReturn {
 						runInfo.env = env
 						return
 					}
-					if runInfo.err == ErrBreak {
-						runInfo.err = nil
-					}
+					runInfo.breakLoop(stmt.Label)
 					break
 				}
 			}
@@ -701,7 +773,8 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 	// CForStmt
 	case *ast.CForStmt:
 		env := runInfo.env
-		runInfo.env = env.NewEnv()
+		loopEnv := env.NewEnv()
+		runInfo.env = loopEnv.NewEnv()
 
 		if stmt.Stmt1 != nil {
 			runInfo.stmt = stmt.Stmt1
@@ -711,6 +784,10 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				return
 			}
 		}
+		var vars []string
+		for name := range runInfo.env.Values() {
+			vars = append(vars, name)
+		}
 
 		for {
 			select {
@@ -733,22 +810,24 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				}
 			}
 
-			runInfo.stmt = stmt.Stmt
-			runInfo.runSingleStmt()
-			if runInfo.err == ErrContinue {
-				runInfo.err = nil
-			}
+			runInfo.runLoopBody(stmt.Stmt, vars)
+			runInfo.continueLoop(stmt.Label)
 			if runInfo.err != nil {
 				if runInfo.err == ErrReturn {
 					runInfo.env = env
 					return
 				}
-				if runInfo.err == ErrBreak {
-					runInfo.err = nil
-				}
+				runInfo.breakLoop(stmt.Label)
 				break
 			}
 
+			// like Go, each iteration has its own copy of the loop variables
+			values := runInfo.env.Values()
+			runInfo.env = loopEnv.NewEnv()
+			for _, name := range vars {
+				runInfo.env.DefineValue(name, values[name])
+			}
+
 			if stmt.Expr3 != nil {
 				runInfo.expr = stmt.Expr3
 				runInfo.invokeExpr()
@@ -791,6 +870,21 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		}
 		runInfo.err = newStringError(stmt, fmt.Sprint(runInfo.rv.Interface()))
 
+	// YieldStmt
+	case *ast.YieldStmt:
+		if runInfo.gen == nil {
+			runInfo.err = newStringError(stmt, "yield outside of generator")
+			runInfo.rv = nilValue
+			return
+		}
+		runInfo.expr = stmt.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		runInfo.err = runInfo.gen.yield(runInfo.rv)
+		runInfo.rv = nilValue
+
 	// ModuleStmt
 	case *ast.ModuleStmt:
 		e := runInfo.env
@@ -827,9 +921,17 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 					runInfo.env = env
 					return
 				}
-				if equal(runInfo.rv, value) {
+				var eq bool
+				eq, runInfo.err = equal(runInfo.ctx, runInfo.rv, value)
+				if runInfo.err != nil {
+					runInfo.rv = nilValue
+					runInfo.env = env
+					return
+				}
+				if eq {
 					runInfo.stmt = caseStmt.Stmt
 					runInfo.runSingleStmt()
+					runInfo.breakLabel(stmt.Label)
 					runInfo.env = env
 					return
 				}
@@ -841,15 +943,29 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 		} else {
 			runInfo.stmt = stmt.Default
 			runInfo.runSingleStmt()
+			runInfo.breakLabel(stmt.Label)
 		}
 
 		runInfo.env = env
 
+	// SelectStmt
+	case *ast.SelectStmt:
+		runInfo.runSelect(stmt)
+
+	// TypeSwitchStmt
+	case *ast.TypeSwitchStmt:
+		runInfo.runTypeSwitch(stmt)
+
 	// GoroutineStmt
 	case *ast.GoroutineStmt:
 		runInfo.expr = stmt.Expr
 		runInfo.invokeExpr()
 
+	// DeferStmt
+	case *ast.DeferStmt:
+		runInfo.expr = stmt.Expr
+		runInfo.invokeExpr()
+
 	// DeleteStmt
 	case *ast.DeleteStmt:
 		runInfo.expr = stmt.Item
@@ -871,6 +987,20 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 			item = item.Elem()
 		}
 
+		if item.Type() == hashMapType {
+			if stmt.Key == nil {
+				runInfo.err = newStringError(stmt, "second argument to delete cannot be nil for map")
+				runInfo.rv = nilValue
+				return
+			}
+			runInfo.err = item.Interface().(*HashMap).deleteContext(runInfo.ctx, runInfo.rv.Interface())
+			if runInfo.err != nil {
+				runInfo.err = newError(stmt, runInfo.err)
+			}
+			runInfo.rv = nilValue
+			return
+		}
+
 		switch item.Kind() {
 		case reflect.String:
 			if stmt.Key != nil && runInfo.rv.Kind() == reflect.Bool && runInfo.rv.Bool() {
@@ -891,9 +1021,11 @@ func (runInfo *runInfoStruct) runSingleStmt() {
 				runInfo.rv = nilValue
 				return
 			}
-			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.rv, item.Type().Key())
+			runInfo.rv, runInfo.err = convertReflectValueToType(runInfo.ctx, runInfo.rv, item.Type().Key())
 			if runInfo.err != nil {
-				runInfo.err = newStringError(stmt, "cannot use type "+item.Type().Key().String()+" as type "+runInfo.rv.Type().String()+" in delete")
+				if !isCallError(runInfo.err) {
+					runInfo.err = newStringError(stmt, conversionMessage(runInfo.err, "cannot use type "+item.Type().Key().String()+" as type "+runInfo.rv.Type().String()+" in delete"))
+				}
 				runInfo.rv = nilValue
 				return
 			}
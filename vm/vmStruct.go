@@ -0,0 +1,282 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dgrr/pako/ast"
+	"github.com/dgrr/pako/env"
+)
+
+// structDefaults are the default values of the fields of a script struct,
+// evaluated in the env of its declaration every time a value of it is made.
+type structDefaults struct {
+	env    *env.Env
+	fields []fieldDefault
+}
+
+// fieldDefault is the default value of the field at path, which has more than one name for fields of nested structs.
+// Fields of script struct types have the defaults of their type instead of an expression.
+type fieldDefault struct {
+	path     []string
+	expr     ast.Expr
+	defaults *structDefaults
+}
+
+// fieldDefaults appends to fields the default values of the fields of typeStruct,
+// including the ones of its nested structs and the ones of the script struct types of its fields.
+func (runInfo *runInfoStruct) fieldDefaults(typeStruct *ast.TypeStruct, path []string, fields []fieldDefault) []fieldDefault {
+	for i, name := range typeStruct.StructNames {
+		fieldPath := append(append([]string{}, path...), name)
+		switch typeStruct.StructTypes[i].Kind {
+		case ast.TypeStructType:
+			fields = runInfo.fieldDefaults(typeStruct.StructTypes[i], fieldPath, fields)
+		case ast.TypeDefault:
+			if defaults := runInfo.typeDefaults(typeStruct.StructTypes[i]); defaults != nil {
+				fields = append(fields, fieldDefault{path: fieldPath, defaults: defaults})
+			}
+		}
+		if i < len(typeStruct.StructDefaults) && typeStruct.StructDefaults[i] != nil {
+			fields = append(fields, fieldDefault{path: fieldPath, expr: typeStruct.StructDefaults[i]})
+		}
+	}
+	return fields
+}
+
+// typeDefaults returns the defaults of the script struct type of typeStruct, or of the one it points to.
+// The type is looked up like makeType does, so the defaults are the ones of the same declaration.
+// It returns nil for other types.
+func (runInfo *runInfoStruct) typeDefaults(typeStruct *ast.TypeStruct) *structDefaults {
+	if typeStruct.Kind == ast.TypePtr && typeStruct.SubType != nil {
+		typeStruct = typeStruct.SubType
+	}
+	if (typeStruct.Kind != ast.TypeDefault && typeStruct.Kind != ast.TypePtr) || typeStruct.Name == "" {
+		return nil
+	}
+	e, err := runInfo.env.GetEnvFromPath(typeStruct.Env)
+	if err != nil {
+		return nil
+	}
+	value, err := e.Defaults(typeStruct.Name)
+	if err != nil {
+		return nil
+	}
+	defaults, _ := value.Interface().(*structDefaults)
+	return defaults
+}
+
+// setDefaults sets the fields of v, a new value of a script struct or a pointer to it, to the defaults of its type.
+func (runInfo *runInfoStruct) setDefaults(defaults *structDefaults, v reflect.Value) error {
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if defaults == nil || v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for _, field := range defaults.fields {
+		fieldV := v
+		for _, name := range field.path {
+			fieldV = fieldV.FieldByName(name)
+		}
+		if field.defaults != nil {
+			if err := runInfo.setDefaults(field.defaults, fieldV); err != nil {
+				return err
+			}
+			continue
+		}
+
+		defaultRunInfo := runInfoStruct{ctx: runInfo.ctx, env: defaults.env.NewEnv(), options: runInfo.options, expr: field.expr, rv: nilValue, vmTypes: runInfo.vmTypes}
+		defaultRunInfo.invokeExpr()
+		if defaultRunInfo.err != nil {
+			return defaultRunInfo.err
+		}
+
+		rv, err := convertReflectValueToType(runInfo.ctx, defaultRunInfo.rv, fieldV.Type())
+		if isCallError(err) {
+			return err
+		}
+		if err != nil {
+			return newStringError(field.expr, "cannot use type "+defaultRunInfo.rv.Type().String()+" as type "+fieldV.Type().String()+" as default value of field "+strings.Join(field.path, "."))
+		}
+		fieldV.Set(rv)
+	}
+	return nil
+}
+
+// isMethodField returns true if the struct field is a script method.
+func isMethodField(field reflect.StructField) bool {
+	t := field.Type
+	return t.Kind() == reflect.Func && t.NumIn() >= 2 && t.In(0) == contextType && t.In(1) == vmStructType
+}
+
+// methodNames returns the field names of the script methods of t, including the ones of its embedded structs.
+func methodNames(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch {
+		case isMethodField(field):
+			names = append(names, field.Name)
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			names = append(names, methodNames(field.Type)...)
+		}
+	}
+	return names
+}
+
+// promoteMethods defines the methods of the structs embedded by the script struct called name as methods of it,
+// except the ones it overrides.
+// Promoted methods are called with the embedding struct as self, so they call its overridden methods.
+func (runInfo *runInfoStruct) promoteMethods(name string, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		embedded := t.Field(i)
+		if !embedded.Anonymous || embedded.Type.Kind() != reflect.Struct {
+			continue
+		}
+		for _, method := range methodNames(embedded.Type) {
+			field, found := t.FieldByName(method)
+			if !found || len(field.Index) == 1 || field.Index[0] != i {
+				// overridden or ambiguous
+				continue
+			}
+			value, err := runInfo.env.Method(embedded.Name + "." + method)
+			if err == nil {
+				runInfo.env.DefineMethod(name+"."+method, value)
+			}
+		}
+	}
+}
+
+// updateEmbeddings redefines the script structs embedding the struct called name with its current type.
+// It is called when a method is declared, as it changes the type of the struct.
+func (runInfo *runInfoStruct) updateEmbeddings(name string) {
+	t, err := runInfo.env.Type(name)
+	if err != nil {
+		return
+	}
+	for _, vmType := range runInfo.vmTypes {
+		styp, err := runInfo.env.Type(vmType)
+		if err != nil || styp.Kind() != reflect.Struct {
+			continue
+		}
+
+		changed := false
+		fields := make([]reflect.StructField, styp.NumField())
+		for i := range fields {
+			fields[i] = styp.Field(i)
+			if fields[i].Anonymous && fields[i].Name == name && fields[i].Type != t {
+				fields[i].Type = t
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		styp = reflect.StructOf(fields)
+		runInfo.env.DefineReflectType(vmType, styp)
+		runInfo.promoteMethods(vmType, styp)
+		runInfo.updateEmbeddings(vmType)
+	}
+}
+
+// invokeStructExpr makes the value of the struct literal expr, with the fields set by expr and the others to their default values.
+// Literals of pointer types, like the ones of Go structs defined with pointers, make pointers to new structs.
+func (runInfo *runInfoStruct) invokeStructExpr(expr *ast.StructExpr) {
+	t := makeType(runInfo, expr.TypeData)
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+		return
+	}
+	if t == nil {
+		runInfo.err = newStringError(expr, "cannot make type nil")
+		runInfo.rv = nilValue
+		return
+	}
+	structType := t
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		runInfo.err = newStringError(expr, fmt.Sprintf("%v is not a struct type", typeStructName(expr.TypeData, t)))
+		runInfo.rv = nilValue
+		return
+	}
+
+	structV, err := makeValue(runInfo, expr.TypeData.Name, structType)
+	if err == nil {
+		err = runInfo.setDefaults(runInfo.typeDefaults(expr.TypeData), structV)
+	}
+	if err != nil {
+		runInfo.err = err
+		runInfo.rv = nilValue
+		return
+	}
+
+	var dataFields []reflect.StructField
+	if len(expr.Fields) > 0 && expr.Fields[0].Name == "" {
+		for i := 0; i < structType.NumField(); i++ {
+			if !isMethodField(structType.Field(i)) {
+				dataFields = append(dataFields, structType.Field(i))
+			}
+		}
+		switch {
+		case len(expr.Fields) < len(dataFields):
+			runInfo.err = newStringError(expr, "too few values in struct literal of type "+typeStructName(expr.TypeData, t))
+		case len(expr.Fields) > len(dataFields):
+			runInfo.err = newStringError(expr, "too many values in struct literal of type "+typeStructName(expr.TypeData, t))
+		}
+		if runInfo.err != nil {
+			runInfo.rv = nilValue
+			return
+		}
+	}
+
+	for i, field := range expr.Fields {
+		var structField reflect.StructField
+		if field.Name == "" {
+			structField = dataFields[i]
+		} else {
+			var found bool
+			structField, found = fieldByName(structType, field.Name)
+			if !found || isMethodField(structField) {
+				runInfo.err = newStringError(field, "no member named '"+field.Name+"' for struct")
+				runInfo.rv = nilValue
+				return
+			}
+		}
+
+		fieldV := structV.FieldByIndex(structField.Index)
+		if !fieldV.CanSet() {
+			runInfo.err = newStringError(field, "struct member '"+structField.Name+"' cannot be assigned")
+			runInfo.rv = nilValue
+			return
+		}
+
+		runInfo.expr = field.Expr
+		runInfo.invokeExpr()
+		if runInfo.err != nil {
+			return
+		}
+		value, err := convertReflectValueToType(runInfo.ctx, runInfo.rv, fieldV.Type())
+		if isCallError(err) {
+			runInfo.err = err
+			runInfo.rv = nilValue
+			return
+		}
+		if err != nil {
+			runInfo.err = newStringError(field, "cannot use type "+runInfo.rv.Type().String()+" as type "+fieldV.Type().String()+" as value of field "+structField.Name)
+			runInfo.rv = nilValue
+			return
+		}
+		fieldV.Set(value)
+	}
+
+	if t.Kind() == reflect.Ptr {
+		runInfo.rv = structV.Addr()
+	} else {
+		runInfo.rv = structV
+	}
+}
@@ -1,6 +1,7 @@
 package vm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -11,6 +12,7 @@ import (
 )
 
 // toString converts all reflect.Value-s into string.
+// It does not call the __str__ method of script structs, use toStringContext for that.
 func toString(v reflect.Value) string {
 	if v.Kind() == reflect.Interface && !v.IsNil() {
 		v = v.Elem()
@@ -24,6 +26,41 @@ func toString(v reflect.Value) string {
 	return fmt.Sprint(v.Interface())
 }
 
+// toStringContext converts all reflect.Value-s into string, calling the __str__ method of script structs with ctx.
+// It returns the error of the __str__ method.
+func toStringContext(ctx context.Context, v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	rv, ok, err := callOperatorMethod(ctx, v, "__str__")
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return toStringContext(ctx, rv)
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// toInterpolatedString converts the value of an interpolation into string.
+// Values implementing over.String use their String method, even with pointer receivers, the others use toStringContext.
+func toInterpolatedString(ctx context.Context, v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.IsValid() && v.Type() != reflectValueType && v.Type().Implements(over.StringReflectType) &&
+		(v.Kind() != reflect.Ptr || !v.IsNil()) {
+		return v.Interface().(over.String).String(), nil
+	}
+	return toStringContext(ctx, v)
+}
+
 // toBool converts all reflect.Value-s into bool.
 func toBool(v reflect.Value) bool {
 	b, _ := tryToBool(v)
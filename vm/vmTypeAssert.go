@@ -0,0 +1,219 @@
+package vm
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dgrr/pako/ast"
+)
+
+// scriptType is a type declared by the script that is not a Go type, as interfaces and enums.
+type scriptType interface {
+	String() string
+	// has returns true if v is of the type.
+	has(v reflect.Value) bool
+}
+
+// vmInterface is the value of script interface declarations.
+// Values implement it if they have all its methods with the same number of parameters,
+// as script struct methods or Go methods.
+type vmInterface struct {
+	name    string
+	methods []*ast.InterfaceMethod
+}
+
+func (i *vmInterface) String() string {
+	return i.name
+}
+
+// missingMethod returns the first method of the interface that v does not have, or an empty string if v implements it.
+func (i *vmInterface) missingMethod(v reflect.Value) string {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	for _, method := range i.methods {
+		if !hasMethod(v, method) {
+			return method.Name
+		}
+	}
+	return ""
+}
+
+// has returns true if v implements the interface.
+func (i *vmInterface) has(v reflect.Value) bool {
+	return i.missingMethod(v) == ""
+}
+
+// hasMethod returns true if v has method, looking for script methods first.
+func hasMethod(v reflect.Value, method *ast.InterfaceMethod) bool {
+	if !v.IsValid() {
+		return false
+	}
+	if f, _ := operatorMethod(v, method.Name); f.IsValid() {
+		t := f.Type()
+		return t.NumIn()-2 == len(method.Params) && t.IsVariadic() == method.VarArg
+	}
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return false
+	}
+	f := v.MethodByName(method.Name)
+	if !f.IsValid() {
+		return false
+	}
+	t := f.Type()
+	return t.NumIn() == len(method.Params) && t.IsVariadic() == method.VarArg
+}
+
+// assertType returns the script type or the type described by typeStruct.
+func (runInfo *runInfoStruct) assertType(pos ast.Pos, typeStruct *ast.TypeStruct) (scriptType, reflect.Type) {
+	if typeStruct.Kind == ast.TypeDefault {
+		e, err := runInfo.env.GetEnvFromPath(typeStruct.Env)
+		if err != nil {
+			runInfo.err = err
+			return nil, nil
+		}
+		if value, err := e.Get(typeStruct.Name); err == nil {
+			if i, ok := value.(scriptType); ok {
+				return i, nil
+			}
+		}
+	}
+	t := makeType(runInfo, typeStruct)
+	if runInfo.err != nil {
+		return nil, nil
+	}
+	if t == nil {
+		runInfo.err = newStringError(pos, "cannot make type nil")
+	}
+	return nil, t
+}
+
+// isType returns true if v is of the script type i or has the type t.
+// Go interface types are checked with Implements, other types have to be identical.
+func isType(v reflect.Value, i scriptType, t reflect.Type) bool {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+		return false
+	}
+	if i != nil {
+		return i.has(v)
+	}
+	if t.Kind() == reflect.Interface {
+		return v.Type().Implements(t)
+	}
+	return v.Type() == t
+}
+
+// typeName returns the name of the type of v, using the name of script structs and enums.
+func (runInfo *runInfoStruct) typeName(v reflect.Value) string {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+		return "nil"
+	}
+	if v.CanInterface() {
+		if member, ok := v.Interface().(*vmEnumValue); ok && member != nil {
+			return member.enum.name
+		}
+	}
+	t := v.Type()
+	prefix := ""
+	if t.Kind() == reflect.Ptr {
+		prefix = "*"
+		t = t.Elem()
+	}
+	for _, name := range runInfo.vmTypes {
+		if vmType, err := runInfo.env.Type(name); err == nil && vmType == t {
+			return prefix + name
+		}
+	}
+	return v.Type().String()
+}
+
+// typeStructName returns the name of typeStruct as written in the script.
+func typeStructName(typeStruct *ast.TypeStruct, t reflect.Type) string {
+	if typeStruct.Kind == ast.TypeDefault {
+		return strings.Join(append(append([]string{}, typeStruct.Env...), typeStruct.Name), ".")
+	}
+	return t.String()
+}
+
+// typeAssert checks the value of expr.Expr against expr.Type, setting runInfo.err if it fails.
+func (runInfo *runInfoStruct) typeAssert(expr *ast.TypeAssertExpr) {
+	runInfo.expr = expr.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return
+	}
+	value := runInfo.rv
+
+	i, t := runInfo.assertType(expr, expr.Type)
+	if runInfo.err != nil {
+		runInfo.rv = nilValue
+		return
+	}
+	if isType(value, i, t) {
+		runInfo.rv = value
+		return
+	}
+
+	name := runInfo.typeName(value)
+	switch {
+	case name == "nil":
+		runInfo.err = newStringError(expr, fmt.Sprintf("nil is not %v", typeStructName(expr.Type, t)))
+	case i != nil:
+		if iface, ok := i.(*vmInterface); ok {
+			runInfo.err = newStringError(expr, fmt.Sprintf("value of type %v does not implement %v (missing method %v)", name, iface.name, iface.missingMethod(value)))
+		} else {
+			runInfo.err = newStringError(expr, fmt.Sprintf("value of type %v is not %v", name, i))
+		}
+	default:
+		runInfo.err = newStringError(expr, fmt.Sprintf("value of type %v is not %v", name, typeStructName(expr.Type, t)))
+	}
+	runInfo.rv = nilValue
+}
+
+// runTypeSwitch runs the first case of stmt with a type of the value of stmt.Expr, or the default case.
+func (runInfo *runInfoStruct) runTypeSwitch(stmt *ast.TypeSwitchStmt) {
+	env := runInfo.env
+	runInfo.env = env.NewEnv()
+	defer func() {
+		runInfo.env = env
+	}()
+
+	runInfo.expr = stmt.Expr
+	runInfo.invokeExpr()
+	if runInfo.err != nil {
+		return
+	}
+	value := runInfo.rv
+
+	for _, switchCaseStmt := range stmt.Cases {
+		caseStmt := switchCaseStmt.(*ast.TypeSwitchCaseStmt)
+		for _, typeStruct := range caseStmt.Types {
+			i, t := runInfo.assertType(caseStmt, typeStruct)
+			if runInfo.err != nil {
+				runInfo.rv = nilValue
+				return
+			}
+			if isType(value, i, t) {
+				runInfo.stmt = caseStmt.Stmt
+				runInfo.runSingleStmt()
+				runInfo.breakLabel(stmt.Label)
+				return
+			}
+		}
+	}
+
+	if stmt.Default == nil {
+		runInfo.rv = nilValue
+	} else {
+		runInfo.stmt = stmt.Default
+		runInfo.runSingleStmt()
+		runInfo.breakLabel(stmt.Label)
+	}
+}
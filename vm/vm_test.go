@@ -12,6 +12,7 @@ import (
 
 	"github.com/dgrr/pako/ast"
 	"github.com/dgrr/pako/env"
+	"github.com/dgrr/pako/parser"
 )
 
 func TestNumbers(t *testing.T) {
@@ -212,6 +213,75 @@ func TestStrings(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+type pointerStringer struct {
+	s string
+}
+
+func (p *pointerStringer) String() string {
+	return "<" + p.s + ">"
+}
+
+func TestInterpolatedStrings(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `f""`, RunOutput: ""},
+		{Script: `f"abc"`, RunOutput: "abc"},
+		{Script: `f'abc'`, RunOutput: "abc"},
+		{Script: `a = 1; f"a${a}b${a + 1}c"`, RunOutput: "a1b2c"},
+		{Script: `a = 1; f"${a}${a}"`, RunOutput: "11"},
+		{Script: `f"\t\n\"\${a} $a $ {a}"`, RunOutput: "\t\n\"${a} $a $ {a}"},
+		{Script: `f"${"a" + 'b'}${ {"c": "d"}["c"] }"`, RunOutput: "abd"},
+		{Script: `f'${f"${1}"}${"}"}'`, RunOutput: "1}"},
+		{Script: `fn f(a) { return a * 2 }; f"${f(2)}"`, RunOutput: "4"},
+		{Script: `f"${nil} ${true} ${1.5} ${[1, 2]}"`, RunOutput: "<nil> true 1.5 [1 2]"},
+		{Script: `f"${a}"`, Input: map[string]interface{}{"a": &pointerStringer{s: "a"}}, RunOutput: "<a>"},
+		{Script: `f"${a}"`, Input: map[string]interface{}{"a": time.Second}, RunOutput: "1s"},
+		{Script: `struct V {
+	X int64
+}
+fn |V| __str__() { return "V(" + self.X + ")" }
+a = make(V); a.X = 1
+f"${a}"`, RunOutput: "V(1)"},
+		{Script: `f = 1; f`, RunOutput: int64(1)},
+
+		{Script: `f"${a}"`, RunError: fmt.Errorf("undefined symbol 'a'")},
+		{Script: `f"${1 +}"`, ParseError: fmt.Errorf("syntax error"), RunOutput: ""},
+		{Script: `f"${}"`, ParseError: fmt.Errorf("empty interpolation"), RunOutput: ""},
+		{Script: `f"${a = 1}"`, ParseError: fmt.Errorf("interpolation must be an expression"), RunOutput: ""},
+		{Script: `f"${a"`, ParseError: fmt.Errorf("unterminated interpolation"), RunOutput: ""},
+		{Script: `f"${"a}"`, ParseError: fmt.Errorf("unterminated interpolation"), RunOutput: ""},
+		{Script: "a = f\"a\n", ParseError: fmt.Errorf("unexpected EOL"), RunOutput: "", Output: map[string]interface{}{"a": ""}},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
+func TestInterpolatedStringsErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	_, err := parser.ParseSrc("a = 1\nb = f\"x ${a +}\"")
+	parseErr, ok := err.(*parser.Error)
+	if !ok {
+		t.Fatalf("ParseSrc error - received: %#v - expected: *parser.Error", err)
+	}
+	if expected := (ast.Position{Line: 2, Column: 14}); parseErr.Pos != expected {
+		t.Errorf("ParseSrc error position - received: %v - expected: %v", parseErr.Pos, expected)
+	}
+
+	stmt, err := parser.ParseSrc("a = 1\nb = f\"x ${a.B}\"")
+	if err != nil {
+		t.Fatalf("ParseSrc error - received: %v - expected: %v", err, nil)
+	}
+	_, err = Run(env.NewEnv(), nil, stmt)
+	runErr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("Run error - received: %#v - expected: *Error", err)
+	}
+	if expected := (ast.Position{Line: 2, Column: 11}); runErr.Pos != expected {
+		t.Errorf("Run error position - received: %v - expected: %v", runErr.Pos, expected)
+	}
+}
+
 func TestVar(t *testing.T) {
 	t.Parallel()
 
@@ -724,6 +794,49 @@ func TestChan(t *testing.T) {
 	runTests(t, tests, nil, &Options{Debug: true})
 }
 
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	tests := []Test{
+		{Script: `select { case 1: }`, ParseError: fmt.Errorf("select case must be receive or send")},
+		{Script: `select { case a, b, c = <-d: }`, ParseError: fmt.Errorf("select case must be receive or send")},
+		{Script: `select { default: 1; default: 2 }`, ParseError: fmt.Errorf("multiple default statement")},
+
+		{Script: `select { default: return 1 }`, RunOutput: int64(1)},
+		{Script: `select { case <-1: }`, RunError: fmt.Errorf("receive from non-chan type int64")},
+		{Script: `select { case 1 <- 1: }`, RunError: fmt.Errorf("send to non-chan type int64")},
+		{Script: `a = make(chan bool, 1); select { case a <- 1: }`, RunError: fmt.Errorf("cannot use type int64 as type bool to send to chan")},
+		{Script: `select { case v = <-c: }`, RunError: fmt.Errorf("undefined symbol 'c'")},
+
+		{Script: `a = make(chan int64, 1); a <- 1; select { case v = <-a: return v; default: return 2 }`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64, 1); select { case v = <-a: return v; default: return 2 }`, RunOutput: int64(2)},
+		{Script: `a = make(chan int64, 1); b = make(chan string, 1); b <- "b"; select { case v = <-a: return v; case v = <-b: return v }`, RunOutput: "b"},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case <-a: return "received" }`, RunOutput: "received"},
+		{Script: `a = make(chan int64, 1); select { case a <- 3: }; <-a`, RunOutput: int64(3)},
+		{Script: `a = make(chan int64); select { case a <- 3: return 1; default: return 2 }`, RunOutput: int64(2)},
+		{Script: `a = make(chan int64, 1); close(a); select { case v, ok = <-a: return [v, ok] }`, RunOutput: []interface{}{int64(0), false}},
+		{Script: `a = make(chan int64, 1); a <- 5; select { case v, ok = <-a: return [v, ok] }`, RunOutput: []interface{}{int64(5), true}},
+		{Script: `a = make(chan int64, 1); a <- 1; v = 0; select { case v = <-a: }; v`, RunOutput: int64(1)},
+		{Script: `a = make(chan int64, 1); a <- 1; select { case v = <-a: }; v`, RunError: fmt.Errorf("undefined symbol 'v'")},
+		{Script: `a = make(chan int64, 1); b = [0, 0]; a <- 1; select { case b[1] = <-a: }; b`, RunOutput: []interface{}{int64(0), int64(1)}},
+
+		{Script: `a = make(chan int64); go fn() { a <- 1 }(); select { case v = <-a: return v; case <-after(1000000000): return "timeout" }`, Input: map[string]interface{}{"after": time.After}, RunOutput: int64(1)},
+		{Script: `a = make(chan int64); select { case v = <-a: return v; case <-after(1000000): return "timeout" }`, Input: map[string]interface{}{"after": time.After}, RunOutput: "timeout"},
+		{Script: `a = make(chan int64, 3); for i = 0; i < 3; i++ { a <- i }; close(a); s = 0
+for {
+	select {
+	case v, ok = <-a:
+		if !ok {
+			break
+		}
+		s += v
+	}
+}
+s`, RunOutput: int64(3)},
+	}
+	runTests(t, tests, nil, &Options{Debug: true})
+}
+
 func TestVMDelete(t *testing.T) {
 	t.Parallel()
 
@@ -891,6 +1004,18 @@ func TestComment(t *testing.T) {
 func TestCancelWithContext(t *testing.T) {
 	scripts := []string{
 		`
+close(waitChan)
+select { }
+`,
+		`
+a = make(chan int64)
+close(waitChan)
+select {
+case v = <-a:
+	b = v
+}
+`,
+		`
 b = 0
 close(waitChan)
 for {
@@ -1009,6 +1134,52 @@ close(waitChan)
 try {
 	for { }
 } catch { }
+`,
+		`
+struct Forever {
+	N int64
+}
+fn |Forever| __next__() { self.N++; return true }
+fn |Forever| __value__() { return self.N }
+close(waitChan)
+for v in make(Forever) { }
+`,
+		`
+fn forever() {
+	for { yield 1 }
+}
+close(waitChan)
+for v in forever() { }
+`,
+		`
+fn busy() {
+	yield 1
+	for { }
+}
+close(waitChan)
+for v in busy() { }
+`,
+		`
+struct S {
+	N int64
+}
+fn |S| __str__() { close(waitChan); for { } }
+"a is " + make(S)
+`,
+		`
+struct S {
+	N int64
+}
+fn |S| __eq__(o) { close(waitChan); for { } }
+a = make(S)
+switch a { case a: return 1 }
+`,
+		`
+struct S {
+	N int64
+}
+fn |S| __hash__() { close(waitChan); for { } }
+m = {make(S): 1}
 `,
 	}
 	for _, script := range scripts {